@@ -8,6 +8,11 @@ package example
 
 import (
 	_ "github.com/catalystcommunity/protoc-gen-go-gorm/options"
+	date "google.golang.org/genproto/googleapis/type/date"
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	latlng "google.golang.org/genproto/googleapis/type/latlng"
+	money "google.golang.org/genproto/googleapis/type/money"
+	timeofday "google.golang.org/genproto/googleapis/type/timeofday"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	structpb "google.golang.org/protobuf/types/known/structpb"
@@ -168,6 +173,30 @@ type User struct {
 	OptionalDate *string `protobuf:"bytes,50,opt,name=optional_date,json=optionalDate,proto3,oneof" json:"optional_date,omitempty" fake:"{date:2006-01-02}"`
 	// @gotags: fake:"skip"
 	SomeTimestamp *timestamppb.Timestamp `protobuf:"bytes,51,opt,name=some_timestamp,json=someTimestamp,proto3" json:"some_timestamp,omitempty" fake:"skip"`
+	// @gotags: fake:"{number:1,9}"
+	NativeEnum EnumOne `protobuf:"varint,52,opt,name=native_enum,json=nativeEnum,proto3,enum=example.cockroachdb.EnumOne" json:"native_enum,omitempty" fake:"{number:1,9}"`
+	// @gotags: fake:"{number:1,9}"
+	NativeEnumList []EnumOne `protobuf:"varint,53,rep,packed,name=native_enum_list,json=nativeEnumList,proto3,enum=example.cockroachdb.EnumOne" json:"native_enum_list,omitempty" fake:"{number:1,9}"`
+	// @gotags: fake:"{number:1,9}"
+	LookupEnum EnumOne `protobuf:"varint,54,opt,name=lookup_enum,json=lookupEnum,proto3,enum=example.cockroachdb.EnumOne" json:"lookup_enum,omitempty" fake:"{number:1,9}"`
+	// @gotags: fake:"{number:1,9}"
+	StrictEnum EnumOne `protobuf:"varint,55,opt,name=strict_enum,json=strictEnum,proto3,enum=example.cockroachdb.EnumOne" json:"strict_enum,omitempty" fake:"{number:1,9}"`
+	// @gotags: fake:"skip"
+	Shipping *PostalAddress `protobuf:"bytes,56,opt,name=shipping,proto3" json:"shipping,omitempty" fake:"skip"`
+	// @gotags: fake:"skip"
+	Billing *PostalAddress `protobuf:"bytes,57,opt,name=billing,proto3" json:"billing,omitempty" fake:"skip"`
+	// @gotags: fake:"{ipv4address}"
+	IpAddress string `protobuf:"bytes,58,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty" fake:"{ipv4address}"`
+	// @gotags: fake:"skip"
+	Birthday *date.Date `protobuf:"bytes,59,opt,name=birthday,proto3" json:"birthday,omitempty" fake:"skip"`
+	// @gotags: fake:"skip"
+	WakeUpTime *timeofday.TimeOfDay `protobuf:"bytes,60,opt,name=wake_up_time,json=wakeUpTime,proto3" json:"wake_up_time,omitempty" fake:"skip"`
+	// @gotags: fake:"skip"
+	Balance *money.Money `protobuf:"bytes,61,opt,name=balance,proto3" json:"balance,omitempty" fake:"skip"`
+	// @gotags: fake:"skip"
+	InterestRate *decimal.Decimal `protobuf:"bytes,62,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty" fake:"skip"`
+	// @gotags: fake:"skip"
+	Location *latlng.LatLng `protobuf:"bytes,63,opt,name=location,proto3" json:"location,omitempty" fake:"skip"`
 }
 
 func (x *User) Reset() {
@@ -447,6 +476,167 @@ func (x *User) GetSomeTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetNativeEnum() EnumOne {
+	if x != nil {
+		return x.NativeEnum
+	}
+	return EnumOne_Default
+}
+
+func (x *User) GetNativeEnumList() []EnumOne {
+	if x != nil {
+		return x.NativeEnumList
+	}
+	return nil
+}
+
+func (x *User) GetLookupEnum() EnumOne {
+	if x != nil {
+		return x.LookupEnum
+	}
+	return EnumOne_Default
+}
+
+func (x *User) GetStrictEnum() EnumOne {
+	if x != nil {
+		return x.StrictEnum
+	}
+	return EnumOne_Default
+}
+
+func (x *User) GetShipping() *PostalAddress {
+	if x != nil {
+		return x.Shipping
+	}
+	return nil
+}
+
+func (x *User) GetBilling() *PostalAddress {
+	if x != nil {
+		return x.Billing
+	}
+	return nil
+}
+
+func (x *User) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *User) GetBirthday() *date.Date {
+	if x != nil {
+		return x.Birthday
+	}
+	return nil
+}
+
+func (x *User) GetWakeUpTime() *timeofday.TimeOfDay {
+	if x != nil {
+		return x.WakeUpTime
+	}
+	return nil
+}
+
+func (x *User) GetBalance() *money.Money {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *User) GetInterestRate() *decimal.Decimal {
+	if x != nil {
+		return x.InterestRate
+	}
+	return nil
+}
+
+func (x *User) GetLocation() *latlng.LatLng {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// PostalAddress is not ormable, it's embedded into the tables of the messages referencing it
+type PostalAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: fake:"{street}"
+	Street string `protobuf:"bytes,1,opt,name=street,proto3" json:"street,omitempty" fake:"{street}"`
+	// @gotags: fake:"{city}"
+	City string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty" fake:"{city}"`
+	// @gotags: fake:"{zip}"
+	PostalCode string `protobuf:"bytes,3,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty" fake:"{zip}"`
+	// @gotags: fake:"{country}"
+	Country *string `protobuf:"bytes,4,opt,name=country,proto3,oneof" json:"country,omitempty" fake:"{country}"`
+}
+
+func (x *PostalAddress) Reset() {
+	*x = PostalAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cockroachdb_example_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PostalAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostalAddress) ProtoMessage() {}
+
+func (x *PostalAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_cockroachdb_example_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostalAddress.ProtoReflect.Descriptor instead.
+func (*PostalAddress) Descriptor() ([]byte, []int) {
+	return file_cockroachdb_example_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PostalAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *PostalAddress) GetCountry() string {
+	if x != nil && x.Country != nil {
+		return *x.Country
+	}
+	return ""
+}
+
+// Company upserts at most two companies per statement, so tests upsert several batches
 type Company struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -465,7 +655,7 @@ type Company struct {
 func (x *Company) Reset() {
 	*x = Company{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cockroachdb_example_proto_msgTypes[1]
+		mi := &file_cockroachdb_example_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -478,7 +668,7 @@ func (x *Company) String() string {
 func (*Company) ProtoMessage() {}
 
 func (x *Company) ProtoReflect() protoreflect.Message {
-	mi := &file_cockroachdb_example_proto_msgTypes[1]
+	mi := &file_cockroachdb_example_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -491,7 +681,7 @@ func (x *Company) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Company.ProtoReflect.Descriptor instead.
 func (*Company) Descriptor() ([]byte, []int) {
-	return file_cockroachdb_example_proto_rawDescGZIP(), []int{1}
+	return file_cockroachdb_example_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Company) GetId() string {
@@ -541,12 +731,18 @@ type Address struct {
 	User *User `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty" fake:"skip"`
 	// @gotags: fake:"skip"
 	CompanyBlob *Company `protobuf:"bytes,7,opt,name=companyBlob,proto3" json:"companyBlob,omitempty" fake:"skip"`
+	// @gotags: fake:"skip"
+	CompanyBlobs []*Company `protobuf:"bytes,8,rep,name=company_blobs,json=companyBlobs,proto3" json:"company_blobs,omitempty" fake:"skip"`
+	// @gotags: fake:"skip"
+	PrimaryTag *Tag `protobuf:"bytes,9,opt,name=primary_tag,json=primaryTag,proto3" json:"primary_tag,omitempty" fake:"skip"`
+	// @gotags: fake:"skip"
+	Tags []*Tag `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty" fake:"skip"`
 }
 
 func (x *Address) Reset() {
 	*x = Address{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cockroachdb_example_proto_msgTypes[2]
+		mi := &file_cockroachdb_example_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -559,7 +755,7 @@ func (x *Address) String() string {
 func (*Address) ProtoMessage() {}
 
 func (x *Address) ProtoReflect() protoreflect.Message {
-	mi := &file_cockroachdb_example_proto_msgTypes[2]
+	mi := &file_cockroachdb_example_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -572,7 +768,7 @@ func (x *Address) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Address.ProtoReflect.Descriptor instead.
 func (*Address) Descriptor() ([]byte, []int) {
-	return file_cockroachdb_example_proto_rawDescGZIP(), []int{2}
+	return file_cockroachdb_example_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Address) GetId() string {
@@ -624,6 +820,85 @@ func (x *Address) GetCompanyBlob() *Company {
 	return nil
 }
 
+func (x *Address) GetCompanyBlobs() []*Company {
+	if x != nil {
+		return x.CompanyBlobs
+	}
+	return nil
+}
+
+func (x *Address) GetPrimaryTag() *Tag {
+	if x != nil {
+		return x.PrimaryTag
+	}
+	return nil
+}
+
+func (x *Address) GetTags() []*Tag {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// Tag is not ormable, so it can only be stored as jsonb
+type Tag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// @gotags: fake:"{word}"
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty" fake:"{word}"`
+	// @gotags: fake:"{word}"
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" fake:"{word}"`
+}
+
+func (x *Tag) Reset() {
+	*x = Tag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cockroachdb_example_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Tag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tag) ProtoMessage() {}
+
+func (x *Tag) ProtoReflect() protoreflect.Message {
+	mi := &file_cockroachdb_example_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tag.ProtoReflect.Descriptor instead.
+func (*Tag) Descriptor() ([]byte, []int) {
+	return file_cockroachdb_example_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Tag) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Tag) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type Comment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -646,7 +921,7 @@ type Comment struct {
 func (x *Comment) Reset() {
 	*x = Comment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cockroachdb_example_proto_msgTypes[3]
+		mi := &file_cockroachdb_example_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -659,7 +934,7 @@ func (x *Comment) String() string {
 func (*Comment) ProtoMessage() {}
 
 func (x *Comment) ProtoReflect() protoreflect.Message {
-	mi := &file_cockroachdb_example_proto_msgTypes[3]
+	mi := &file_cockroachdb_example_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +947,7 @@ func (x *Comment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Comment.ProtoReflect.Descriptor instead.
 func (*Comment) Descriptor() ([]byte, []int) {
-	return file_cockroachdb_example_proto_rawDescGZIP(), []int{3}
+	return file_cockroachdb_example_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Comment) GetId() string {
@@ -735,7 +1010,7 @@ type Profile struct {
 func (x *Profile) Reset() {
 	*x = Profile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cockroachdb_example_proto_msgTypes[4]
+		mi := &file_cockroachdb_example_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -748,7 +1023,7 @@ func (x *Profile) String() string {
 func (*Profile) ProtoMessage() {}
 
 func (x *Profile) ProtoReflect() protoreflect.Message {
-	mi := &file_cockroachdb_example_proto_msgTypes[4]
+	mi := &file_cockroachdb_example_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -761,7 +1036,7 @@ func (x *Profile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Profile.ProtoReflect.Descriptor instead.
 func (*Profile) Descriptor() ([]byte, []int) {
-	return file_cockroachdb_example_proto_rawDescGZIP(), []int{4}
+	return file_cockroachdb_example_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Profile) GetId() string {
@@ -803,127 +1078,198 @@ var file_cockroachdb_example_proto_rawDesc = []byte{
 	0x6f, 0x1a, 0x12, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x67, 0x6f, 0x72, 0x6d, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0xc6, 0x0d, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01,
-	0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61,
-	0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x61,
-	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x5f, 0x66, 0x6c, 0x6f, 0x61,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x46, 0x6c, 0x6f, 0x61, 0x74, 0x12,
-	0x19, 0x0a, 0x08, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x07, 0x61, 0x6e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6e,
-	0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x6e,
-	0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x5f, 0x62, 0x6f, 0x6f, 0x6c, 0x18,
-	0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x42, 0x6f, 0x6f, 0x6c, 0x12, 0x19, 0x0a, 0x08,
-	0x61, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x5f, 0x62, 0x79, 0x74,
-	0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x13, 0x20, 0x03, 0x28,
-	0x01, 0x52, 0x07, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6c,
-	0x6f, 0x61, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x66, 0x6c, 0x6f, 0x61,
-	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x18, 0x15, 0x20, 0x03,
-	0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e,
-	0x74, 0x36, 0x34, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x36,
-	0x34, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x1f, 0x20, 0x03, 0x28,
-	0x08, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x79, 0x74, 0x65, 0x73, 0x73, 0x18, 0x21, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x06, 0x62, 0x79, 0x74, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x15, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x5f, 0x66, 0x69,
-	0x65, 0x6c, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x13, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x70,
-	0x62, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
-	0x52, 0x09, 0x61, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x70, 0x62, 0x12, 0x21, 0x0a, 0x09, 0x63,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
-	0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x47,
-	0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x25, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f,
-	0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x0f, 0xba,
-	0xb9, 0x19, 0x0b, 0x22, 0x00, 0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x07,
-	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x29, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x5f, 0x74, 0x77, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x26, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54, 0x77, 0x6f, 0x49, 0x64, 0x88,
-	0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x77,
-	0x6f, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
-	0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x43, 0x6f,
-	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x22, 0x00, 0x52, 0x07, 0x43,
-	0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54,
-	0x77, 0x6f, 0x12, 0x2d, 0x0a, 0x10, 0x61, 0x6e, 0x5f, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x0e,
-	0x61, 0x6e, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x64, 0x88, 0x01,
-	0x01, 0x12, 0x62, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x68, 0x72,
-	0x65, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x43,
-	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x1f, 0xba, 0xb9, 0x19, 0x1b, 0x22, 0x10, 0x0a, 0x0e,
-	0x41, 0x6e, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49, 0x64, 0x52, 0x07,
-	0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x54, 0x68, 0x72, 0x65, 0x65, 0x12, 0x47, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x42, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x1a, 0x00, 0x52, 0x07, 0x43, 0x41,
-	0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x49,
-	0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x2b, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72,
-	0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x0f,
-	0xba, 0xb9, 0x19, 0x0b, 0x2a, 0x00, 0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52,
-	0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x08, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64,
-	0x62, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x32,
-	0x00, 0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
-	0x18, 0x2d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
-	0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x75,
-	0x6d, 0x4f, 0x6e, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x45, 0x0a,
-	0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x2e, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63,
-	0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e, 0x65,
-	0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x40, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x45, 0x6e, 0x75, 0x6d, 0x12, 0x40, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d,
-	0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x2f, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78,
+	0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x2f, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74,
+	0x79, 0x70, 0x65, 0x2f, 0x6c, 0x61, 0x74, 0x6c, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x6d, 0x6f,
+	0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x66, 0x64, 0x61, 0x79,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa7, 0x14, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12,
+	0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x61, 0x5f, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x61, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x5f, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x06, 0x61, 0x46, 0x6c, 0x6f,
+	0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x61, 0x6e, 0x49, 0x6e, 0x74, 0x33, 0x32, 0x12, 0x19, 0x0a,
+	0x08, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x61, 0x6e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x15, 0x0a, 0x06, 0x61, 0x5f, 0x62, 0x6f,
+	0x6f, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x42, 0x6f, 0x6f, 0x6c, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x61, 0x5f,
+	0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x01, 0x52, 0x07, 0x64, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x66, 0x6c, 0x6f, 0x61, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x02, 0x52, 0x06, 0x66,
+	0x6c, 0x6f, 0x61, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x18,
+	0x15, 0x20, 0x03, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28, 0x03, 0x52, 0x06, 0x69,
+	0x6e, 0x74, 0x36, 0x34, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x1f,
+	0x20, 0x03, 0x28, 0x08, 0x52, 0x05, 0x62, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x20, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x79, 0x74, 0x65, 0x73, 0x73, 0x18,
+	0x21, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x79, 0x74, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a,
+	0x15, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72,
+	0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x13,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x88, 0x01, 0x01, 0x12, 0x36, 0x0a, 0x0a, 0x61, 0x5f, 0x73, 0x74, 0x72, 0x75,
+	0x63, 0x74, 0x70, 0x62, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x52, 0x09, 0x61, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x70, 0x62, 0x12, 0x21,
+	0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x18, 0x24, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x02, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x88, 0x01,
+	0x01, 0x12, 0x47, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x18, 0x25, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63,
+	0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x42, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x22, 0x00, 0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44,
+	0x45, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x29, 0x0a, 0x0e, 0x63, 0x6f,
+	0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x74, 0x77, 0x6f, 0x5f, 0x69, 0x64, 0x18, 0x26, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x03, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x54, 0x77, 0x6f,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
+	0x5f, 0x74, 0x77, 0x6f, 0x18, 0x27, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x22, 0x00,
+	0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x54, 0x77, 0x6f, 0x12, 0x2d, 0x0a, 0x10, 0x61, 0x6e, 0x5f, 0x75, 0x6e, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x04, 0x52, 0x0e, 0x61, 0x6e, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x62, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78,
 	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64,
-	0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x52, 0x0b, 0x69, 0x6e, 0x74, 0x45, 0x6e,
-	0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x30, 0x20, 0x03, 0x28, 0x0e,
-	0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72,
-	0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x42, 0x06,
-	0xba, 0xb9, 0x19, 0x02, 0x40, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x45, 0x6e,
-	0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x31,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0xb9, 0x19, 0x0c, 0x5a, 0x0a, 0x32, 0x30, 0x30, 0x36,
-	0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0d,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x32, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0xb9, 0x19, 0x0c, 0x5a, 0x0a, 0x32, 0x30, 0x30, 0x36, 0x2d,
-	0x30, 0x31, 0x2d, 0x30, 0x32, 0x48, 0x05, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61,
-	0x6c, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x6f, 0x6d, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x6f,
-	0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x3a, 0x06, 0xba, 0xb9, 0x19,
-	0x02, 0x08, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6c, 0x61, 0x72, 0x5f, 0x66,
-	0x69, 0x65, 0x6c, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79,
-	0x49, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x74,
-	0x77, 0x6f, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x6e, 0x5f, 0x75, 0x6e, 0x65,
-	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x22, 0xb7, 0x01, 0x0a,
-	0x07, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x42,
-	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0xe9, 0x02, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x1f, 0xba, 0xb9, 0x19, 0x1b, 0x22,
+	0x10, 0x0a, 0x0e, 0x41, 0x6e, 0x55, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x49,
+	0x64, 0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x0c, 0x63, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x54, 0x68, 0x72, 0x65, 0x65, 0x12, 0x47, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x1a, 0x00, 0x52,
+	0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x49, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x2b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f,
+	0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x42, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x2a, 0x00, 0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41,
+	0x44, 0x45, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x2c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61,
+	0x63, 0x68, 0x64, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x42, 0x0f, 0xba, 0xb9,
+	0x19, 0x0b, 0x32, 0x00, 0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x08, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x5f, 0x65,
+	0x6e, 0x75, 0x6d, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e,
+	0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x74, 0x45, 0x6e, 0x75, 0x6d,
+	0x12, 0x45, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18,
+	0x2e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d,
+	0x4f, 0x6e, 0x65, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x40, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x40, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x5f, 0x65,
+	0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x2f, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x1c,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61,
+	0x63, 0x68, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x52, 0x0b, 0x69, 0x6e,
+	0x74, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x10, 0x73, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x30, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f,
+	0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e,
+	0x65, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x40, 0x01, 0x52, 0x0e, 0x73, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x45, 0x6e, 0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x31, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0xb9, 0x19, 0x0c, 0x5a, 0x0a, 0x32,
+	0x30, 0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x3a, 0x0a, 0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x42, 0x10, 0xba, 0xb9, 0x19, 0x0c, 0x5a, 0x0a, 0x32, 0x30,
+	0x30, 0x36, 0x2d, 0x30, 0x31, 0x2d, 0x30, 0x32, 0x48, 0x05, 0x52, 0x0c, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x0e, 0x73,
+	0x6f, 0x6d, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x33, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x0d, 0x73, 0x6f, 0x6d, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x45,
+	0x0a, 0x0b, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x34, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f,
+	0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e,
+	0x65, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x68, 0x03, 0x52, 0x0a, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x4e, 0x0a, 0x10, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f,
+	0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x35, 0x20, 0x03, 0x28, 0x0e, 0x32,
+	0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f,
+	0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x42, 0x06, 0xba,
+	0xb9, 0x19, 0x02, 0x68, 0x03, 0x52, 0x0e, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x45, 0x6e, 0x75,
+	0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x0b, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x5f,
+	0x65, 0x6e, 0x75, 0x6d, 0x18, 0x36, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x68, 0x04,
+	0x52, 0x0a, 0x6c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x47, 0x0a, 0x0b,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x37, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b,
+	0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x42,
+	0x08, 0xba, 0xb9, 0x19, 0x04, 0x68, 0x02, 0x70, 0x01, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x69, 0x63,
+	0x74, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x18, 0x38, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x50, 0x6f,
+	0x73, 0x74, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x06, 0xba, 0xb9, 0x19,
+	0x02, 0x78, 0x01, 0x52, 0x08, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x4f, 0x0a,
+	0x07, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x18, 0x39, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61,
+	0x63, 0x68, 0x64, 0x62, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x11, 0xba, 0xb9, 0x19, 0x0d, 0x78, 0x01, 0x82, 0x01, 0x08, 0x62, 0x69, 0x6c,
+	0x6c, 0x5f, 0x74, 0x6f, 0x5f, 0x52, 0x07, 0x62, 0x69, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x9b,
+	0x01, 0x0a, 0x0a, 0x69, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x3a, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x7c, 0xba, 0xb9, 0x19, 0x78, 0x8a, 0x01, 0x75, 0x0a, 0x43, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x79, 0x73,
+	0x74, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x06, 0x49, 0x50, 0x41, 0x64, 0x64, 0x72, 0x1a, 0x10, 0x49, 0x50, 0x41, 0x64, 0x64,
+	0x72, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x0e, 0x49, 0x50, 0x41,
+	0x64, 0x64, 0x72, 0x54, 0x6f, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2a, 0x04, 0x69, 0x6e, 0x65,
+	0x74, 0x52, 0x09, 0x69, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x08,
+	0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x3b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x61, 0x74,
+	0x65, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x38, 0x0a, 0x0c, 0x77,
+	0x61, 0x6b, 0x65, 0x5f, 0x75, 0x70, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x3c, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x4f, 0x66, 0x44, 0x61, 0x79, 0x52, 0x0a, 0x77, 0x61, 0x6b, 0x65, 0x55,
+	0x70, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2c, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x3d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f,
+	0x72, 0x61, 0x74, 0x65, 0x18, 0x3e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c,
+	0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2f,
+	0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x3f, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4c,
+	0x61, 0x74, 0x4c, 0x6e, 0x67, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a,
+	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x18,
+	0x0a, 0x16, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6c,
+	0x61, 0x72, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x49, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x61,
+	0x6e, 0x79, 0x5f, 0x74, 0x77, 0x6f, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x6e,
+	0x5f, 0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x42, 0x10,
+	0x0a, 0x0e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x64, 0x61, 0x74, 0x65,
+	0x22, 0x87, 0x01, 0x0a, 0x0d, 0x50, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69,
+	0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x42, 0x0a,
+	0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x22, 0xb9, 0x01, 0x0a, 0x07, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x08, 0xba, 0xb9, 0x19, 0x04, 0x08, 0x01, 0x40, 0x02, 0x42,
+	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x22, 0xb4, 0x04, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65,
 	0x73, 0x73, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
 	0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
@@ -940,54 +1286,71 @@ var file_cockroachdb_example_proto_rawDesc = []byte{
 	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63,
 	0x68, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x22, 0x00,
 	0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
-	0x46, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x6c, 0x6f, 0x62, 0x18, 0x07,
+	0x4d, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x6c, 0x6f, 0x62, 0x18, 0x07,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63,
 	0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61,
-	0x6e, 0x79, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x60, 0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
-	0x61, 0x6e, 0x79, 0x42, 0x6c, 0x6f, 0x62, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x42,
-	0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x22, 0x9f, 0x02, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x13,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64,
-	0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x04, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x55,
-	0x73, 0x65, 0x72, 0x42, 0x0f, 0xba, 0xb9, 0x19, 0x0b, 0x22, 0x00, 0x52, 0x07, 0x43, 0x41, 0x53,
-	0x43, 0x41, 0x44, 0x45, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02,
-	0x08, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0xb7, 0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
-	0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02,
-	0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a,
-	0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x2a, 0x70,
-	0x0a, 0x07, 0x45, 0x6e, 0x75, 0x6d, 0x4f, 0x6e, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x4f, 0x6e, 0x65, 0x10, 0x01, 0x12,
-	0x07, 0x0a, 0x03, 0x54, 0x77, 0x6f, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x68, 0x72, 0x65,
-	0x65, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x6f, 0x75, 0x72, 0x10, 0x04, 0x12, 0x08, 0x0a,
-	0x04, 0x46, 0x69, 0x76, 0x65, 0x10, 0x05, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x69, 0x78, 0x10, 0x06,
-	0x12, 0x09, 0x0a, 0x05, 0x53, 0x65, 0x76, 0x65, 0x6e, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x45,
-	0x69, 0x67, 0x68, 0x74, 0x10, 0x08, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x69, 0x6e, 0x65, 0x10, 0x09,
-	0x42, 0x43, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x5a, 0x3b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x79, 0x73, 0x74, 0x73, 0x71, 0x75,
-	0x61, 0x64, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f,
-	0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3b, 0x65, 0x78,
-	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x79, 0x42, 0x0d, 0xba, 0xb9, 0x19, 0x09, 0x60, 0x01, 0xa2, 0x01, 0x04, 0x08, 0x01, 0x18,
+	0x01, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x49,
+	0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x6e, 0x79, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18,
+	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e,
+	0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x6e, 0x79, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x60, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6d,
+	0x70, 0x61, 0x6e, 0x79, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x41, 0x0a, 0x0b, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61,
+	0x63, 0x68, 0x64, 0x62, 0x2e, 0x54, 0x61, 0x67, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x60, 0x01,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x61, 0x67, 0x12, 0x34, 0x0a, 0x04,
+	0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x65, 0x78, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63, 0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62,
+	0x2e, 0x54, 0x61, 0x67, 0x42, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x60, 0x01, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69,
+	0x64, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x22, 0x2d, 0x0a,
+	0x03, 0x54, 0x61, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x9f, 0x02, 0x0a,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x63,
+	0x6b, 0x72, 0x6f, 0x61, 0x63, 0x68, 0x64, 0x62, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0f, 0xba,
+	0xb9, 0x19, 0x0b, 0x22, 0x00, 0x52, 0x07, 0x43, 0x41, 0x53, 0x43, 0x41, 0x44, 0x45, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x42, 0x05, 0x0a, 0x03,
+	0x5f, 0x69, 0x64, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb7,
+	0x01, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x13, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x02, 0x69, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x06, 0xba, 0xb9, 0x19, 0x02, 0x08,
+	0x01, 0x42, 0x05, 0x0a, 0x03, 0x5f, 0x69, 0x64, 0x2a, 0x7c, 0x0a, 0x07, 0x45, 0x6e, 0x75, 0x6d,
+	0x4f, 0x6e, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x10, 0x00,
+	0x12, 0x07, 0x0a, 0x03, 0x4f, 0x6e, 0x65, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x54, 0x77, 0x6f,
+	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x68, 0x72, 0x65, 0x65, 0x10, 0x03, 0x12, 0x08, 0x0a,
+	0x04, 0x46, 0x6f, 0x75, 0x72, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x69, 0x76, 0x65, 0x10,
+	0x05, 0x12, 0x07, 0x0a, 0x03, 0x53, 0x69, 0x78, 0x10, 0x06, 0x12, 0x09, 0x0a, 0x05, 0x53, 0x65,
+	0x76, 0x65, 0x6e, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x69, 0x67, 0x68, 0x74, 0x10, 0x08,
+	0x12, 0x14, 0x0a, 0x04, 0x4e, 0x69, 0x6e, 0x65, 0x10, 0x09, 0x1a, 0x0a, 0xba, 0xb9, 0x19, 0x06,
+	0x0a, 0x04, 0x6e, 0x69, 0x6e, 0x65, 0x42, 0x47, 0xba, 0xb9, 0x19, 0x02, 0x08, 0x01, 0x5a, 0x3f,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x74, 0x61, 0x6c,
+	0x79, 0x73, 0x74, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x67, 0x6f, 0x2d, 0x67, 0x6f, 0x72, 0x6d, 0x2f,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3b, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1003,47 +1366,68 @@ func file_cockroachdb_example_proto_rawDescGZIP() []byte {
 }
 
 var file_cockroachdb_example_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_cockroachdb_example_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_cockroachdb_example_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_cockroachdb_example_proto_goTypes = []interface{}{
 	(EnumOne)(0),                  // 0: example.cockroachdb.EnumOne
 	(*User)(nil),                  // 1: example.cockroachdb.User
-	(*Company)(nil),               // 2: example.cockroachdb.Company
-	(*Address)(nil),               // 3: example.cockroachdb.Address
-	(*Comment)(nil),               // 4: example.cockroachdb.Comment
-	(*Profile)(nil),               // 5: example.cockroachdb.Profile
-	(*timestamppb.Timestamp)(nil), // 6: google.protobuf.Timestamp
-	(*structpb.Struct)(nil),       // 7: google.protobuf.Struct
+	(*PostalAddress)(nil),         // 2: example.cockroachdb.PostalAddress
+	(*Company)(nil),               // 3: example.cockroachdb.Company
+	(*Address)(nil),               // 4: example.cockroachdb.Address
+	(*Tag)(nil),                   // 5: example.cockroachdb.Tag
+	(*Comment)(nil),               // 6: example.cockroachdb.Comment
+	(*Profile)(nil),               // 7: example.cockroachdb.Profile
+	(*timestamppb.Timestamp)(nil), // 8: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),       // 9: google.protobuf.Struct
+	(*date.Date)(nil),             // 10: google.type.Date
+	(*timeofday.TimeOfDay)(nil),   // 11: google.type.TimeOfDay
+	(*money.Money)(nil),           // 12: google.type.Money
+	(*decimal.Decimal)(nil),       // 13: google.type.Decimal
+	(*latlng.LatLng)(nil),         // 14: google.type.LatLng
 }
 var file_cockroachdb_example_proto_depIdxs = []int32{
-	6,  // 0: example.cockroachdb.User.updated_at:type_name -> google.protobuf.Timestamp
-	7,  // 1: example.cockroachdb.User.a_structpb:type_name -> google.protobuf.Struct
-	2,  // 2: example.cockroachdb.User.company:type_name -> example.cockroachdb.Company
-	2,  // 3: example.cockroachdb.User.company_two:type_name -> example.cockroachdb.Company
-	2,  // 4: example.cockroachdb.User.company_three:type_name -> example.cockroachdb.Company
-	3,  // 5: example.cockroachdb.User.address:type_name -> example.cockroachdb.Address
-	4,  // 6: example.cockroachdb.User.comments:type_name -> example.cockroachdb.Comment
-	5,  // 7: example.cockroachdb.User.profiles:type_name -> example.cockroachdb.Profile
+	8,  // 0: example.cockroachdb.User.updated_at:type_name -> google.protobuf.Timestamp
+	9,  // 1: example.cockroachdb.User.a_structpb:type_name -> google.protobuf.Struct
+	3,  // 2: example.cockroachdb.User.company:type_name -> example.cockroachdb.Company
+	3,  // 3: example.cockroachdb.User.company_two:type_name -> example.cockroachdb.Company
+	3,  // 4: example.cockroachdb.User.company_three:type_name -> example.cockroachdb.Company
+	4,  // 5: example.cockroachdb.User.address:type_name -> example.cockroachdb.Address
+	6,  // 6: example.cockroachdb.User.comments:type_name -> example.cockroachdb.Comment
+	7,  // 7: example.cockroachdb.User.profiles:type_name -> example.cockroachdb.Profile
 	0,  // 8: example.cockroachdb.User.int_enum:type_name -> example.cockroachdb.EnumOne
 	0,  // 9: example.cockroachdb.User.string_enum:type_name -> example.cockroachdb.EnumOne
 	0,  // 10: example.cockroachdb.User.int_enum_list:type_name -> example.cockroachdb.EnumOne
 	0,  // 11: example.cockroachdb.User.string_enum_list:type_name -> example.cockroachdb.EnumOne
-	6,  // 12: example.cockroachdb.User.some_timestamp:type_name -> google.protobuf.Timestamp
-	6,  // 13: example.cockroachdb.Company.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 14: example.cockroachdb.Company.updated_at:type_name -> google.protobuf.Timestamp
-	6,  // 15: example.cockroachdb.Address.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 16: example.cockroachdb.Address.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 17: example.cockroachdb.Address.user:type_name -> example.cockroachdb.User
-	2,  // 18: example.cockroachdb.Address.companyBlob:type_name -> example.cockroachdb.Company
-	6,  // 19: example.cockroachdb.Comment.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 20: example.cockroachdb.Comment.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 21: example.cockroachdb.Comment.user:type_name -> example.cockroachdb.User
-	6,  // 22: example.cockroachdb.Profile.created_at:type_name -> google.protobuf.Timestamp
-	6,  // 23: example.cockroachdb.Profile.updated_at:type_name -> google.protobuf.Timestamp
-	24, // [24:24] is the sub-list for method output_type
-	24, // [24:24] is the sub-list for method input_type
-	24, // [24:24] is the sub-list for extension type_name
-	24, // [24:24] is the sub-list for extension extendee
-	0,  // [0:24] is the sub-list for field type_name
+	8,  // 12: example.cockroachdb.User.some_timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 13: example.cockroachdb.User.native_enum:type_name -> example.cockroachdb.EnumOne
+	0,  // 14: example.cockroachdb.User.native_enum_list:type_name -> example.cockroachdb.EnumOne
+	0,  // 15: example.cockroachdb.User.lookup_enum:type_name -> example.cockroachdb.EnumOne
+	0,  // 16: example.cockroachdb.User.strict_enum:type_name -> example.cockroachdb.EnumOne
+	2,  // 17: example.cockroachdb.User.shipping:type_name -> example.cockroachdb.PostalAddress
+	2,  // 18: example.cockroachdb.User.billing:type_name -> example.cockroachdb.PostalAddress
+	10, // 19: example.cockroachdb.User.birthday:type_name -> google.type.Date
+	11, // 20: example.cockroachdb.User.wake_up_time:type_name -> google.type.TimeOfDay
+	12, // 21: example.cockroachdb.User.balance:type_name -> google.type.Money
+	13, // 22: example.cockroachdb.User.interest_rate:type_name -> google.type.Decimal
+	14, // 23: example.cockroachdb.User.location:type_name -> google.type.LatLng
+	8,  // 24: example.cockroachdb.Company.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 25: example.cockroachdb.Company.updated_at:type_name -> google.protobuf.Timestamp
+	8,  // 26: example.cockroachdb.Address.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 27: example.cockroachdb.Address.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 28: example.cockroachdb.Address.user:type_name -> example.cockroachdb.User
+	3,  // 29: example.cockroachdb.Address.companyBlob:type_name -> example.cockroachdb.Company
+	3,  // 30: example.cockroachdb.Address.company_blobs:type_name -> example.cockroachdb.Company
+	5,  // 31: example.cockroachdb.Address.primary_tag:type_name -> example.cockroachdb.Tag
+	5,  // 32: example.cockroachdb.Address.tags:type_name -> example.cockroachdb.Tag
+	8,  // 33: example.cockroachdb.Comment.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 34: example.cockroachdb.Comment.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 35: example.cockroachdb.Comment.user:type_name -> example.cockroachdb.User
+	8,  // 36: example.cockroachdb.Profile.created_at:type_name -> google.protobuf.Timestamp
+	8,  // 37: example.cockroachdb.Profile.updated_at:type_name -> google.protobuf.Timestamp
+	38, // [38:38] is the sub-list for method output_type
+	38, // [38:38] is the sub-list for method input_type
+	38, // [38:38] is the sub-list for extension type_name
+	38, // [38:38] is the sub-list for extension extendee
+	0,  // [0:38] is the sub-list for field type_name
 }
 
 func init() { file_cockroachdb_example_proto_init() }
@@ -1065,7 +1449,7 @@ func file_cockroachdb_example_proto_init() {
 			}
 		}
 		file_cockroachdb_example_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Company); i {
+			switch v := v.(*PostalAddress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1077,7 +1461,7 @@ func file_cockroachdb_example_proto_init() {
 			}
 		}
 		file_cockroachdb_example_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
+			switch v := v.(*Company); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1089,7 +1473,7 @@ func file_cockroachdb_example_proto_init() {
 			}
 		}
 		file_cockroachdb_example_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Comment); i {
+			switch v := v.(*Address); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1101,6 +1485,30 @@ func file_cockroachdb_example_proto_init() {
 			}
 		}
 		file_cockroachdb_example_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cockroachdb_example_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Comment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cockroachdb_example_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Profile); i {
 			case 0:
 				return &v.state
@@ -1117,14 +1525,15 @@ func file_cockroachdb_example_proto_init() {
 	file_cockroachdb_example_proto_msgTypes[1].OneofWrappers = []interface{}{}
 	file_cockroachdb_example_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	file_cockroachdb_example_proto_msgTypes[3].OneofWrappers = []interface{}{}
-	file_cockroachdb_example_proto_msgTypes[4].OneofWrappers = []interface{}{}
+	file_cockroachdb_example_proto_msgTypes[5].OneofWrappers = []interface{}{}
+	file_cockroachdb_example_proto_msgTypes[6].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cockroachdb_example_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
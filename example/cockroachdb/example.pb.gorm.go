@@ -5,21 +5,27 @@ package example
 
 import (
 	context "context"
-	json "encoding/json"
+	fmt "fmt"
+	customtypes "github.com/catalystcommunity/protoc-gen-go-gorm/example/customtypes"
+	gormtypes "github.com/catalystcommunity/protoc-gen-go-gorm/gormtypes"
 	gorm_jsonb "github.com/dariubs/gorm-jsonb"
 	uuid "github.com/google/uuid"
 	pq "github.com/lib/pq"
 	lo "github.com/samber/lo"
+	date "google.golang.org/genproto/googleapis/type/date"
+	decimal "google.golang.org/genproto/googleapis/type/decimal"
+	latlng "google.golang.org/genproto/googleapis/type/latlng"
+	money "google.golang.org/genproto/googleapis/type/money"
+	timeofday "google.golang.org/genproto/googleapis/type/timeofday"
+	protojson "google.golang.org/protobuf/encoding/protojson"
+	proto "google.golang.org/protobuf/proto"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	gorm "gorm.io/gorm"
 	clause "gorm.io/gorm/clause"
-	sync "sync"
 	time "time"
 )
 
-// cockroachdb doesn't support nanosecond timestamp columns so use microsecond instead
-const TimestampFormat = "2006-01-02T15:04:05.999999Z07:00"
-
 type UserGormModels []*UserGormModel
 type UserProtos []*User
 type UserGormModel struct {
@@ -79,7 +85,7 @@ type UserGormModel struct {
 	OptionalScalarField *string `json:"optionalScalarField" fake:"skip"`
 
 	// @gotags: fake:"skip"
-	AStructpb gorm_jsonb.JSONB `gorm:"type:jsonb" json:"aStructpb" fake:"skip"`
+	AStructpb gorm_jsonb.JSONB `gorm:"type:jsonb;" json:"aStructpb" fake:"skip"`
 
 	// @gotags: fake:"skip"
 	CompanyId *string `json:"companyId" fake:"skip"`
@@ -128,6 +134,45 @@ type UserGormModel struct {
 
 	// @gotags: fake:"skip"
 	SomeTimestamp *time.Time `gorm:"type:timestamp;" json:"someTimestamp" fake:"skip"`
+
+	// @gotags: fake:"{number:1,9}"
+	NativeEnum string `gorm:"type:enum_one;" json:"nativeEnum" fake:"{number:1,9}"`
+
+	// @gotags: fake:"{number:1,9}"
+	NativeEnumList pq.StringArray `gorm:"type:enum_one[];" json:"nativeEnumList" fake:"{number:1,9}"`
+
+	// @gotags: fake:"{number:1,9}"
+	LookupEnum       int                     `json:"lookupEnum" fake:"{number:1,9}"`
+	LookupEnumLookup *EnumOneLookupGormModel `gorm:"foreignKey:LookupEnum;references:Id" json:"-"`
+
+	// @gotags: fake:"{number:1,9}"
+	StrictEnum string `json:"strictEnum" fake:"{number:1,9}"`
+
+	// @gotags: fake:"skip"
+	Shipping PostalAddressGormEmbedded `gorm:"embedded;embeddedPrefix:shipping_;" json:"shipping" fake:"skip"`
+
+	// @gotags: fake:"skip"
+	Billing PostalAddressGormEmbedded `gorm:"embedded;embeddedPrefix:bill_to_;" json:"billing" fake:"skip"`
+
+	// @gotags: fake:"{ipv4address}"
+	IpAddress customtypes.IPAddr `gorm:"type:inet;" json:"ipAddress" fake:"{ipv4address}"`
+
+	// @gotags: fake:"skip"
+	Birthday *time.Time `gorm:"type:date;" json:"birthday" fake:"skip"`
+
+	// @gotags: fake:"skip"
+	WakeUpTime *string `gorm:"type:time;" json:"wakeUpTime" fake:"skip"`
+
+	// @gotags: fake:"skip"
+	BalanceAmount   *string `gorm:"type:numeric;" json:"balanceAmount" fake:"skip"`
+	BalanceCurrency *string `json:"balanceCurrency"`
+
+	// @gotags: fake:"skip"
+	InterestRate *string `gorm:"type:numeric;" json:"interestRate" fake:"skip"`
+
+	// @gotags: fake:"skip"
+	LocationLatitude  *float64 `json:"locationLatitude" fake:"skip"`
+	LocationLongitude *float64 `json:"locationLongitude"`
 }
 
 func (m *UserGormModel) TableName() string {
@@ -139,7 +184,7 @@ func (m UserGormModels) ToProtos() (protos UserProtos, err error) {
 	for _, model := range m {
 		var proto *User
 		if proto, err = model.ToProto(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		protos = append(protos, proto)
 	}
@@ -151,7 +196,7 @@ func (p UserProtos) ToModels() (models UserGormModels, err error) {
 	for _, proto := range p {
 		var model *UserGormModel
 		if model, err = proto.ToModel(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		models = append(models, model)
 	}
@@ -205,11 +250,8 @@ func (m *UserGormModel) ToProto() (theProto *User, err error) {
 	theProto.OptionalScalarField = m.OptionalScalarField
 
 	if m.AStructpb != nil {
-		var jsonBytes []byte
-		if jsonBytes, err = json.Marshal(m.AStructpb); err != nil {
-			return
-		}
-		if err = json.Unmarshal(jsonBytes, &theProto.AStructpb); err != nil {
+		theProto.AStructpb = &structpb.Struct{}
+		if err = gormtypes.JSONBToMessage(m.AStructpb, theProto.AStructpb); err != nil {
 			return
 		}
 	}
@@ -262,7 +304,9 @@ func (m *UserGormModel) ToProto() (theProto *User, err error) {
 
 	theProto.IntEnum = EnumOne(m.IntEnum)
 
-	theProto.StringEnum = EnumOne(EnumOne_value[m.StringEnum])
+	if theProto.StringEnum, err = EnumOneFromStoredValue(m.StringEnum, false); err != nil {
+		return
+	}
 
 	if len(m.IntEnumList) > 0 {
 		theProto.IntEnumList = []EnumOne{}
@@ -274,7 +318,11 @@ func (m *UserGormModel) ToProto() (theProto *User, err error) {
 	if len(m.StringEnumList) > 0 {
 		theProto.StringEnumList = []EnumOne{}
 		for _, val := range m.StringEnumList {
-			theProto.StringEnumList = append(theProto.StringEnumList, EnumOne(EnumOne_value[val]))
+			var enumValue EnumOne
+			if enumValue, err = EnumOneFromStoredValue(val, false); err != nil {
+				return
+			}
+			theProto.StringEnumList = append(theProto.StringEnumList, enumValue)
 		}
 	}
 
@@ -290,6 +338,72 @@ func (m *UserGormModel) ToProto() (theProto *User, err error) {
 		theProto.SomeTimestamp = timestamppb.New(*m.SomeTimestamp)
 	}
 
+	if theProto.NativeEnum, err = EnumOneFromStoredValue(m.NativeEnum, false); err != nil {
+		return
+	}
+
+	if len(m.NativeEnumList) > 0 {
+		theProto.NativeEnumList = []EnumOne{}
+		for _, val := range m.NativeEnumList {
+			var enumValue EnumOne
+			if enumValue, err = EnumOneFromStoredValue(val, false); err != nil {
+				return
+			}
+			theProto.NativeEnumList = append(theProto.NativeEnumList, enumValue)
+		}
+	}
+
+	theProto.LookupEnum = EnumOne(m.LookupEnum)
+
+	if theProto.StrictEnum, err = EnumOneFromStoredValue(m.StrictEnum, true); err != nil {
+		return
+	}
+
+	// an unset Shipping is stored as zero columns, so zero columns are read back as unset
+	if !m.Shipping.isZero() {
+		if theProto.Shipping, err = m.Shipping.ToProto(); err != nil {
+			return
+		}
+	}
+
+	// an unset Billing is stored as zero columns, so zero columns are read back as unset
+	if !m.Billing.isZero() {
+		if theProto.Billing, err = m.Billing.ToProto(); err != nil {
+			return
+		}
+	}
+
+	if theProto.IpAddress, err = customtypes.IPAddrToString(m.IpAddress); err != nil {
+		return
+	}
+
+	if m.Birthday != nil {
+		theProto.Birthday = &date.Date{Year: int32(m.Birthday.Year()), Month: int32(m.Birthday.Month()), Day: int32(m.Birthday.Day())}
+	}
+
+	if m.WakeUpTime != nil {
+		var timeOfDay time.Time
+		if timeOfDay, err = time.Parse("15:04:05.999999999", *m.WakeUpTime); err != nil {
+			return
+		}
+		theProto.WakeUpTime = &timeofday.TimeOfDay{Hours: int32(timeOfDay.Hour()), Minutes: int32(timeOfDay.Minute()), Seconds: int32(timeOfDay.Second()), Nanos: int32(timeOfDay.Nanosecond())}
+	}
+
+	if m.BalanceAmount != nil {
+		theProto.Balance = &money.Money{CurrencyCode: lo.FromPtr(m.BalanceCurrency)}
+		if theProto.Balance.Units, theProto.Balance.Nanos, err = gormtypes.ParseMoneyAmount(*m.BalanceAmount); err != nil {
+			return
+		}
+	}
+
+	if m.InterestRate != nil {
+		theProto.InterestRate = &decimal.Decimal{Value: *m.InterestRate}
+	}
+
+	if m.LocationLatitude != nil && m.LocationLongitude != nil {
+		theProto.Location = &latlng.LatLng{Latitude: *m.LocationLatitude, Longitude: *m.LocationLongitude}
+	}
+
 	return
 }
 
@@ -367,11 +481,7 @@ func (p *User) ToModel() (theModel *UserGormModel, err error) {
 	theModel.OptionalScalarField = p.OptionalScalarField
 
 	if p.AStructpb != nil {
-		var jsonBytes []byte
-		if jsonBytes, err = json.Marshal(p.AStructpb); err != nil {
-			return
-		}
-		if err = json.Unmarshal(jsonBytes, &theModel.AStructpb); err != nil {
+		if theModel.AStructpb, err = gormtypes.MessageToJSONB(p.AStructpb, protojson.MarshalOptions{}); err != nil {
 			return
 		}
 	}
@@ -439,7 +549,7 @@ func (p *User) ToModel() (theModel *UserGormModel, err error) {
 
 	theModel.IntEnum = int(p.IntEnum)
 
-	theModel.StringEnum = p.StringEnum.String()
+	theModel.StringEnum = EnumOneToStoredValue(p.StringEnum)
 
 	if len(p.IntEnumList) > 0 {
 		theModel.IntEnumList = pq.Int32Array{}
@@ -451,7 +561,7 @@ func (p *User) ToModel() (theModel *UserGormModel, err error) {
 	if len(p.StringEnumList) > 0 {
 		theModel.StringEnumList = pq.StringArray{}
 		for _, val := range p.StringEnumList {
-			theModel.StringEnumList = append(theModel.StringEnumList, val.String())
+			theModel.StringEnumList = append(theModel.StringEnumList, EnumOneToStoredValue(val))
 		}
 	}
 
@@ -477,10 +587,72 @@ func (p *User) ToModel() (theModel *UserGormModel, err error) {
 		theModel.SomeTimestamp = lo.ToPtr(p.SomeTimestamp.AsTime())
 	}
 
+	theModel.NativeEnum = EnumOneToStoredValue(p.NativeEnum)
+
+	if len(p.NativeEnumList) > 0 {
+		theModel.NativeEnumList = pq.StringArray{}
+		for _, val := range p.NativeEnumList {
+			theModel.NativeEnumList = append(theModel.NativeEnumList, EnumOneToStoredValue(val))
+		}
+	}
+
+	theModel.LookupEnum = int(p.LookupEnum)
+
+	theModel.StrictEnum = EnumOneToStoredValue(p.StrictEnum)
+
+	if p.Shipping != nil {
+		var ShippingEmbedded *PostalAddressGormEmbedded
+		if ShippingEmbedded, err = p.Shipping.ToGormEmbedded(); err != nil {
+			return
+		}
+		theModel.Shipping = *ShippingEmbedded
+	}
+
+	if p.Billing != nil {
+		var BillingEmbedded *PostalAddressGormEmbedded
+		if BillingEmbedded, err = p.Billing.ToGormEmbedded(); err != nil {
+			return
+		}
+		theModel.Billing = *BillingEmbedded
+	}
+
+	if theModel.IpAddress, err = customtypes.IPAddrFromString(p.IpAddress); err != nil {
+		return
+	}
+
+	if p.Birthday != nil {
+		// a date column can't hold a date without a year, month or day
+		if p.Birthday.Year == 0 || p.Birthday.Month == 0 || p.Birthday.Day == 0 {
+			err = fmt.Errorf("field example.cockroachdb.User.birthday: partial dates can't be stored")
+			return
+		}
+		theModel.Birthday = lo.ToPtr(time.Date(int(p.Birthday.Year), time.Month(p.Birthday.Month), int(p.Birthday.Day), 0, 0, 0, 0, time.UTC))
+	}
+
+	if p.WakeUpTime != nil {
+		theModel.WakeUpTime = lo.ToPtr(fmt.Sprintf("%02d:%02d:%02d.%09d", p.WakeUpTime.Hours, p.WakeUpTime.Minutes, p.WakeUpTime.Seconds, p.WakeUpTime.Nanos))
+	}
+
+	if p.Balance != nil {
+		theModel.BalanceAmount = lo.ToPtr(gormtypes.FormatMoneyAmount(p.Balance.Units, p.Balance.Nanos))
+		theModel.BalanceCurrency = lo.ToPtr(p.Balance.CurrencyCode)
+	}
+
+	if p.InterestRate != nil {
+		theModel.InterestRate = lo.ToPtr(p.InterestRate.Value)
+	}
+
+	if p.Location != nil {
+		theModel.LocationLatitude = lo.ToPtr(p.Location.Latitude)
+		theModel.LocationLongitude = lo.ToPtr(p.Location.Longitude)
+	}
+
 	return
 }
 
 func (m UserGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	ids := []string{}
 	for _, model := range m {
 		if model.Id != nil {
@@ -492,7 +664,8 @@ func (m UserGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, preloads
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		err = statement.Where("id in ?", ids).Find(&m).Error
+		statement = statement.Where("id in ?", ids)
+		err = statement.Find(&m).Error
 	}
 	return
 }
@@ -500,6 +673,8 @@ func (m UserGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, preloads
 // Upsert creates the protos using an on conflict clause to do updates. This function does not update *any* associations
 // use gorm's association mode functions to update associations as you see fit after calling upsert. See https://gorm.io/docs/associations.html#Replace-Associations
 func (p *UserProtos) Upsert(ctx context.Context, tx *gorm.DB) (models UserGormModels, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		for _, proto := range *p {
 			if proto.Id == nil {
@@ -512,19 +687,24 @@ func (p *UserProtos) Upsert(ctx context.Context, tx *gorm.DB) (models UserGormMo
 		}
 		// create new session so the tx isn't modified
 		session := tx.Session(&gorm.Session{})
-		err = session.
+		_, err = CreateInBatches(session.
 			// on conflict, update all fields
 			Clauses(clause.OnConflict{
 				UpdateAll: true,
 			}).
 			// exclude associations from upsert
-			Omit(clause.Associations).
-			Create(&models).Error
+			Omit(clause.Associations), models)
+		if err == nil {
+			// update the protos with the models, which are the rows as stored
+			err = RefreshProtos(*p, models)
+		}
 	}
 	return
 }
 
 func (p *UserProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int, order interface{}, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models UserGormModels
 		statement := tx.Preload(clause.Associations).Limit(limit).Offset(offset)
@@ -547,13 +727,16 @@ func (p *UserProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int, o
 }
 
 func (p *UserProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models UserGormModels
 		statement := tx.Preload(clause.Associations)
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		if err = statement.Where("id in ?", ids).Find(&models).Error; err != nil {
+		statement = statement.Where("id in ?", ids)
+		if err = statement.Find(&models).Error; err != nil {
 			return
 		}
 		if len(models) > 0 {
@@ -565,11 +748,310 @@ func (p *UserProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string, pr
 	return
 }
 
-func DeleteUserGormModels(ctx context.Context, tx *gorm.DB, ids []string) error {
-	statement := tx.Where("id in ?", ids)
-	return statement.Delete(&UserGormModel{}).Error
+// DeleteUserGormModels deletes the models with the given ids, returning the deleted models and protos
+func DeleteUserGormModels(ctx context.Context, tx *gorm.DB, ids []string) (models UserGormModels, protos UserProtos, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
+	remove := func(tx *gorm.DB) (err error) {
+		statement := tx.Where("id in ?", ids)
+		models, err = DeleteReturning[*UserGormModel](statement)
+		return
+	}
+	if err = remove(tx); err != nil {
+		return
+	}
+	protos, err = models.ToProtos()
+	return
+}
+
+// UserRepository reads and writes Users, so services can depend on it rather than on
+// gorm. UserGormRepository is the gorm implementation
+type UserRepository interface {
+	GetByIds(ctx context.Context, ids []string, preloads ...string) (UserProtos, error)
+	List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (UserProtos, error)
+	// Upsert creates or updates the protos, without their associations, and returns them with their ids set
+	Upsert(ctx context.Context, protos UserProtos) (UserProtos, error)
+	// Delete deletes the rows of the ids, and returns the deleted rows without their associations
+	Delete(ctx context.Context, ids []string) (UserProtos, error)
+	AssociateProfiles(ctx context.Context, associations *ManyToManyAssociations) error
+	DissociateProfiles(ctx context.Context, associations *ManyToManyAssociations) error
+	ReplaceProfiles(ctx context.Context, associations *ManyToManyAssociations) error
+}
+
+// UserGormRepository is the UserRepository backed by the generated gorm functions, its db may be a transaction
+type UserGormRepository struct {
+	db *gorm.DB
+}
+
+var _ UserRepository = &UserGormRepository{}
+
+func NewUserGormRepository(db *gorm.DB) *UserGormRepository {
+	return &UserGormRepository{db: db}
+}
+
+func (r *UserGormRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (protos UserProtos, err error) {
+	err = protos.GetByIds(ctx, r.db.WithContext(ctx), ids, preloads...)
+	return
+}
+
+func (r *UserGormRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (protos UserProtos, err error) {
+	err = protos.List(ctx, r.db.WithContext(ctx), limit, offset, order, preloads...)
+	return
+}
+
+func (r *UserGormRepository) Upsert(ctx context.Context, protos UserProtos) (UserProtos, error) {
+	_, err := protos.Upsert(ctx, r.db.WithContext(ctx))
+	return protos, err
+}
+
+func (r *UserGormRepository) Delete(ctx context.Context, ids []string) (UserProtos, error) {
+	_, protos, err := DeleteUserGormModels(ctx, r.db.WithContext(ctx), ids)
+	return protos, err
+}
+
+func (r *UserGormRepository) AssociateProfiles(ctx context.Context, associations *ManyToManyAssociations) error {
+	return AssociateManyToMany[*UserGormModel, *ProfileGormModel](ctx, r.db.WithContext(ctx), associations, "Profiles")
+}
+
+func (r *UserGormRepository) DissociateProfiles(ctx context.Context, associations *ManyToManyAssociations) error {
+	return DissociateManyToMany[*UserGormModel, *ProfileGormModel](ctx, r.db.WithContext(ctx), associations, "Profiles")
+}
+
+func (r *UserGormRepository) ReplaceProfiles(ctx context.Context, associations *ManyToManyAssociations) error {
+	return ReplaceManyToMany[*UserGormModel, *ProfileGormModel](ctx, r.db.WithContext(ctx), associations, "Profiles")
+}
+
+// UserFakeRepository is an in memory UserRepository for tests. Like the gorm repository, upserts
+// set missing ids and timestamps without saving associations, and reads load the associations to messages of the same
+// go package one level deep
+type UserFakeRepository struct {
+	store *FakeStore
+}
+
+var _ UserRepository = &UserFakeRepository{}
+
+func NewUserFakeRepository(store *FakeStore) *UserFakeRepository {
+	return &UserFakeRepository{store: store}
+}
+
+// UserFakeColumns are the columns fake repositories can order Users by
+var UserFakeColumns = map[string]func(*UserGormModel) interface{}{
+	"id":                    func(model *UserGormModel) interface{} { return model.Id },
+	"created_at":            func(model *UserGormModel) interface{} { return model.CreatedAt },
+	"updated_at":            func(model *UserGormModel) interface{} { return model.UpdatedAt },
+	"a_double":              func(model *UserGormModel) interface{} { return model.ADouble },
+	"a_float":               func(model *UserGormModel) interface{} { return model.AFloat },
+	"an_int32":              func(model *UserGormModel) interface{} { return model.AnInt32 },
+	"an_int64":              func(model *UserGormModel) interface{} { return model.AnInt64 },
+	"a_bool":                func(model *UserGormModel) interface{} { return model.ABool },
+	"a_string":              func(model *UserGormModel) interface{} { return model.AString },
+	"a_bytes":               func(model *UserGormModel) interface{} { return model.ABytes },
+	"optional_scalar_field": func(model *UserGormModel) interface{} { return model.OptionalScalarField },
+	"company_id":            func(model *UserGormModel) interface{} { return model.CompanyId },
+	"company_two_id":        func(model *UserGormModel) interface{} { return model.CompanyTwoId },
+	"an_unexpected_id":      func(model *UserGormModel) interface{} { return model.AnUnexpectedId },
+	"int_enum":              func(model *UserGormModel) interface{} { return model.IntEnum },
+	"string_enum":           func(model *UserGormModel) interface{} { return model.StringEnum },
+	"date":                  func(model *UserGormModel) interface{} { return model.Date },
+	"optional_date":         func(model *UserGormModel) interface{} { return model.OptionalDate },
+	"some_timestamp":        func(model *UserGormModel) interface{} { return model.SomeTimestamp },
+	"native_enum":           func(model *UserGormModel) interface{} { return model.NativeEnum },
+	"lookup_enum":           func(model *UserGormModel) interface{} { return model.LookupEnum },
+	"strict_enum":           func(model *UserGormModel) interface{} { return model.StrictEnum },
+	"ip_address":            func(model *UserGormModel) interface{} { return model.IpAddress },
+	"birthday":              func(model *UserGormModel) interface{} { return model.Birthday },
+	"wake_up_time":          func(model *UserGormModel) interface{} { return model.WakeUpTime },
+	"balance_amount":        func(model *UserGormModel) interface{} { return model.BalanceAmount },
+	"balance_currency":      func(model *UserGormModel) interface{} { return model.BalanceCurrency },
+	"interest_rate":         func(model *UserGormModel) interface{} { return model.InterestRate },
+	"location_latitude":     func(model *UserGormModel) interface{} { return model.LocationLatitude },
+	"location_longitude":    func(model *UserGormModel) interface{} { return model.LocationLongitude },
+}
+
+func (r *UserFakeRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (UserProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*UserGormModel{}
+	for _, id := range lo.Uniq(ids) {
+		if row, ok := r.store.tables["users"][id]; ok {
+			models = append(models, row.(*UserGormModel))
+		}
+	}
+	return r.toProtos(models)
+}
+
+func (r *UserFakeRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (UserProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*UserGormModel{}
+	for _, row := range r.store.tables["users"] {
+		models = append(models, row.(*UserGormModel))
+	}
+	if err := fakeOrder(models, order, UserFakeColumns); err != nil {
+		return nil, err
+	}
+	return r.toProtos(fakePage(models, limit, offset))
+}
+
+func (r *UserFakeRepository) Upsert(ctx context.Context, protos UserProtos) (UserProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	stored := UserProtos{}
+	for _, theProto := range protos {
+		if theProto.Id == nil {
+			theProto.Id = lo.ToPtr(uuid.New().String())
+		}
+		stored = append(stored, proto.Clone(theProto).(*User))
+	}
+	models, err := stored.ToModels()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	for _, model := range models {
+		existing, exists := r.store.tables["users"][*model.Id].(*UserGormModel)
+		model.Company = nil
+		model.CompanyTwo = nil
+		model.CompanyThree = nil
+		model.Address = nil
+		model.Comments = nil
+		model.Profiles = nil
+		if exists {
+			model.CreatedAt = existing.CreatedAt
+		} else if model.CreatedAt == nil {
+			model.CreatedAt = &now
+		}
+		if model.UpdatedAt == nil {
+			model.UpdatedAt = &now
+		}
+		r.store.tables["users"][*model.Id] = model
+	}
+	// like the gorm upsert, the protos are updated with the rows as stored
+	if stored, err = r.toProtos(models); err != nil {
+		return nil, err
+	}
+	for i, theProto := range protos {
+		proto.Reset(theProto)
+		proto.Merge(theProto, stored[i])
+	}
+	return protos, nil
+}
+
+func (r *UserFakeRepository) Delete(ctx context.Context, ids []string) (UserProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	models := UserGormModels{}
+	for _, id := range ids {
+		row, ok := r.store.tables["users"][id]
+		if !ok {
+			continue
+		}
+		models = append(models, row.(*UserGormModel))
+		delete(r.store.tables["users"], id)
+		r.store.deleteAssociations("users", id)
+	}
+	// like the gorm delete, the deleted rows are returned without their associations
+	return models.ToProtos()
+}
+
+// toProtos converts the stored models, and loads their associations from the store
+func (r *UserFakeRepository) toProtos(models []*UserGormModel) (protos UserProtos, err error) {
+	protos = UserProtos{}
+	for _, model := range models {
+		var theProto *User
+		if theProto, err = model.ToProto(); err != nil {
+			return
+		}
+		theProto = proto.Clone(theProto).(*User)
+		if row, ok := r.store.tables["companies"][lo.FromPtr(model.CompanyId)]; ok {
+			if theProto.Company, err = row.(*CompanyGormModel).ToProto(); err != nil {
+				return
+			}
+		}
+		if row, ok := r.store.tables["companies"][lo.FromPtr(model.CompanyTwoId)]; ok {
+			if theProto.CompanyTwo, err = row.(*CompanyGormModel).ToProto(); err != nil {
+				return
+			}
+		}
+		if row, ok := r.store.tables["companies"][lo.FromPtr(model.AnUnexpectedId)]; ok {
+			if theProto.CompanyThree, err = row.(*CompanyGormModel).ToProto(); err != nil {
+				return
+			}
+		}
+		for _, id := range fakeSortedKeys(r.store.tables["addresses"]) {
+			if related := r.store.tables["addresses"][id].(*AddressGormModel); lo.FromPtr(related.UserId) == *model.Id {
+				if theProto.Address, err = related.ToProto(); err != nil {
+					return
+				}
+				break
+			}
+		}
+		for _, id := range fakeSortedKeys(r.store.tables["comments"]) {
+			if related := r.store.tables["comments"][id].(*CommentGormModel); lo.FromPtr(related.UserId) == *model.Id {
+				relatedProto, err := related.ToProto()
+				if err != nil {
+					return nil, err
+				}
+				theProto.Comments = append(theProto.Comments, relatedProto)
+			}
+		}
+		for _, id := range fakeSortedKeys(r.store.joinTables["User.Profiles"][*model.Id]) {
+			if row, ok := r.store.tables["profiles"][id]; ok {
+				relatedProto, err := row.(*ProfileGormModel).ToProto()
+				if err != nil {
+					return nil, err
+				}
+				theProto.Profiles = append(theProto.Profiles, relatedProto)
+			}
+		}
+		protos = append(protos, theProto)
+	}
+	return
+}
+
+func (r *UserFakeRepository) AssociateProfiles(ctx context.Context, associations *ManyToManyAssociations) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	joinTable := r.store.joinTables["User.Profiles"]
+	for id, associatedIds := range associations.Associations() {
+		if joinTable[id] == nil {
+			joinTable[id] = map[string]bool{}
+		}
+		for _, associatedId := range associatedIds {
+			joinTable[id][associatedId] = true
+		}
+	}
+	return nil
+}
+
+func (r *UserFakeRepository) DissociateProfiles(ctx context.Context, associations *ManyToManyAssociations) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	joinTable := r.store.joinTables["User.Profiles"]
+	for id, associatedIds := range associations.Associations() {
+		for _, associatedId := range associatedIds {
+			delete(joinTable[id], associatedId)
+		}
+	}
+	return nil
+}
+
+func (r *UserFakeRepository) ReplaceProfiles(ctx context.Context, associations *ManyToManyAssociations) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	joinTable := r.store.joinTables["User.Profiles"]
+	for id, associatedIds := range associations.Associations() {
+		joinTable[id] = map[string]bool{}
+		for _, associatedId := range associatedIds {
+			joinTable[id][associatedId] = true
+		}
+	}
+	return nil
 }
 
+// UserGormModelTable is the name of the User table
+const UserGormModelTable = "users"
+
 type CompanyGormModels []*CompanyGormModel
 type CompanyProtos []*Company
 type CompanyGormModel struct {
@@ -591,12 +1073,17 @@ func (m *CompanyGormModel) TableName() string {
 	return "companies"
 }
 
+// UpsertBatchSize caps the number of models upserts insert per statement, see CreateInBatches
+func (m *CompanyGormModel) UpsertBatchSize() int {
+	return 2
+}
+
 func (m CompanyGormModels) ToProtos() (protos CompanyProtos, err error) {
 	protos = CompanyProtos{}
 	for _, model := range m {
 		var proto *Company
 		if proto, err = model.ToProto(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		protos = append(protos, proto)
 	}
@@ -608,7 +1095,7 @@ func (p CompanyProtos) ToModels() (models CompanyGormModels, err error) {
 	for _, proto := range p {
 		var model *CompanyGormModel
 		if model, err = proto.ToModel(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		models = append(models, model)
 	}
@@ -681,6 +1168,8 @@ func (p *Company) ToModel() (theModel *CompanyGormModel, err error) {
 }
 
 func (m CompanyGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	ids := []string{}
 	for _, model := range m {
 		if model.Id != nil {
@@ -692,7 +1181,8 @@ func (m CompanyGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, prelo
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		err = statement.Where("id in ?", ids).Find(&m).Error
+		statement = statement.Where("id in ?", ids)
+		err = statement.Find(&m).Error
 	}
 	return
 }
@@ -700,6 +1190,8 @@ func (m CompanyGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, prelo
 // Upsert creates the protos using an on conflict clause to do updates. This function does not update *any* associations
 // use gorm's association mode functions to update associations as you see fit after calling upsert. See https://gorm.io/docs/associations.html#Replace-Associations
 func (p *CompanyProtos) Upsert(ctx context.Context, tx *gorm.DB) (models CompanyGormModels, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		for _, proto := range *p {
 			if proto.Id == nil {
@@ -712,19 +1204,24 @@ func (p *CompanyProtos) Upsert(ctx context.Context, tx *gorm.DB) (models Company
 		}
 		// create new session so the tx isn't modified
 		session := tx.Session(&gorm.Session{})
-		err = session.
+		_, err = CreateInBatches(session.
 			// on conflict, update all fields
 			Clauses(clause.OnConflict{
 				UpdateAll: true,
 			}).
 			// exclude associations from upsert
-			Omit(clause.Associations).
-			Create(&models).Error
+			Omit(clause.Associations), models)
+		if err == nil {
+			// update the protos with the models, which are the rows as stored
+			err = RefreshProtos(*p, models)
+		}
 	}
 	return
 }
 
 func (p *CompanyProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int, order interface{}, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models CompanyGormModels
 		statement := tx.Preload(clause.Associations).Limit(limit).Offset(offset)
@@ -747,13 +1244,16 @@ func (p *CompanyProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int
 }
 
 func (p *CompanyProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models CompanyGormModels
 		statement := tx.Preload(clause.Associations)
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		if err = statement.Where("id in ?", ids).Find(&models).Error; err != nil {
+		statement = statement.Where("id in ?", ids)
+		if err = statement.Find(&models).Error; err != nil {
 			return
 		}
 		if len(models) > 0 {
@@ -765,11 +1265,182 @@ func (p *CompanyProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string,
 	return
 }
 
-func DeleteCompanyGormModels(ctx context.Context, tx *gorm.DB, ids []string) error {
-	statement := tx.Where("id in ?", ids)
-	return statement.Delete(&CompanyGormModel{}).Error
+// DeleteCompanyGormModels deletes the models with the given ids, returning the deleted models and protos
+func DeleteCompanyGormModels(ctx context.Context, tx *gorm.DB, ids []string) (models CompanyGormModels, protos CompanyProtos, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
+	remove := func(tx *gorm.DB) (err error) {
+		statement := tx.Where("id in ?", ids)
+		models, err = DeleteReturning[*CompanyGormModel](statement)
+		return
+	}
+	if err = remove(tx); err != nil {
+		return
+	}
+	protos, err = models.ToProtos()
+	return
+}
+
+// CompanyRepository reads and writes Companys, so services can depend on it rather than on
+// gorm. CompanyGormRepository is the gorm implementation
+type CompanyRepository interface {
+	GetByIds(ctx context.Context, ids []string, preloads ...string) (CompanyProtos, error)
+	List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (CompanyProtos, error)
+	// Upsert creates or updates the protos, without their associations, and returns them with their ids set
+	Upsert(ctx context.Context, protos CompanyProtos) (CompanyProtos, error)
+	// Delete deletes the rows of the ids, and returns the deleted rows without their associations
+	Delete(ctx context.Context, ids []string) (CompanyProtos, error)
+}
+
+// CompanyGormRepository is the CompanyRepository backed by the generated gorm functions, its db may be a transaction
+type CompanyGormRepository struct {
+	db *gorm.DB
+}
+
+var _ CompanyRepository = &CompanyGormRepository{}
+
+func NewCompanyGormRepository(db *gorm.DB) *CompanyGormRepository {
+	return &CompanyGormRepository{db: db}
+}
+
+func (r *CompanyGormRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (protos CompanyProtos, err error) {
+	err = protos.GetByIds(ctx, r.db.WithContext(ctx), ids, preloads...)
+	return
+}
+
+func (r *CompanyGormRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (protos CompanyProtos, err error) {
+	err = protos.List(ctx, r.db.WithContext(ctx), limit, offset, order, preloads...)
+	return
+}
+
+func (r *CompanyGormRepository) Upsert(ctx context.Context, protos CompanyProtos) (CompanyProtos, error) {
+	_, err := protos.Upsert(ctx, r.db.WithContext(ctx))
+	return protos, err
+}
+
+func (r *CompanyGormRepository) Delete(ctx context.Context, ids []string) (CompanyProtos, error) {
+	_, protos, err := DeleteCompanyGormModels(ctx, r.db.WithContext(ctx), ids)
+	return protos, err
+}
+
+// CompanyFakeRepository is an in memory CompanyRepository for tests. Like the gorm repository, upserts
+// set missing ids and timestamps without saving associations, and reads load the associations to messages of the same
+// go package one level deep
+type CompanyFakeRepository struct {
+	store *FakeStore
+}
+
+var _ CompanyRepository = &CompanyFakeRepository{}
+
+func NewCompanyFakeRepository(store *FakeStore) *CompanyFakeRepository {
+	return &CompanyFakeRepository{store: store}
+}
+
+// CompanyFakeColumns are the columns fake repositories can order Companys by
+var CompanyFakeColumns = map[string]func(*CompanyGormModel) interface{}{
+	"id":         func(model *CompanyGormModel) interface{} { return model.Id },
+	"created_at": func(model *CompanyGormModel) interface{} { return model.CreatedAt },
+	"updated_at": func(model *CompanyGormModel) interface{} { return model.UpdatedAt },
+	"name":       func(model *CompanyGormModel) interface{} { return model.Name },
+}
+
+func (r *CompanyFakeRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (CompanyProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*CompanyGormModel{}
+	for _, id := range lo.Uniq(ids) {
+		if row, ok := r.store.tables["companies"][id]; ok {
+			models = append(models, row.(*CompanyGormModel))
+		}
+	}
+	return r.toProtos(models)
+}
+
+func (r *CompanyFakeRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (CompanyProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*CompanyGormModel{}
+	for _, row := range r.store.tables["companies"] {
+		models = append(models, row.(*CompanyGormModel))
+	}
+	if err := fakeOrder(models, order, CompanyFakeColumns); err != nil {
+		return nil, err
+	}
+	return r.toProtos(fakePage(models, limit, offset))
+}
+
+func (r *CompanyFakeRepository) Upsert(ctx context.Context, protos CompanyProtos) (CompanyProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	stored := CompanyProtos{}
+	for _, theProto := range protos {
+		if theProto.Id == nil {
+			theProto.Id = lo.ToPtr(uuid.New().String())
+		}
+		stored = append(stored, proto.Clone(theProto).(*Company))
+	}
+	models, err := stored.ToModels()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	for _, model := range models {
+		existing, exists := r.store.tables["companies"][*model.Id].(*CompanyGormModel)
+		if exists {
+			model.CreatedAt = existing.CreatedAt
+		} else if model.CreatedAt == nil {
+			model.CreatedAt = &now
+		}
+		if model.UpdatedAt == nil {
+			model.UpdatedAt = &now
+		}
+		r.store.tables["companies"][*model.Id] = model
+	}
+	// like the gorm upsert, the protos are updated with the rows as stored
+	if stored, err = r.toProtos(models); err != nil {
+		return nil, err
+	}
+	for i, theProto := range protos {
+		proto.Reset(theProto)
+		proto.Merge(theProto, stored[i])
+	}
+	return protos, nil
+}
+
+func (r *CompanyFakeRepository) Delete(ctx context.Context, ids []string) (CompanyProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	models := CompanyGormModels{}
+	for _, id := range ids {
+		row, ok := r.store.tables["companies"][id]
+		if !ok {
+			continue
+		}
+		models = append(models, row.(*CompanyGormModel))
+		delete(r.store.tables["companies"], id)
+		r.store.deleteAssociations("companies", id)
+	}
+	// like the gorm delete, the deleted rows are returned without their associations
+	return models.ToProtos()
 }
 
+// toProtos converts the stored models, and loads their associations from the store
+func (r *CompanyFakeRepository) toProtos(models []*CompanyGormModel) (protos CompanyProtos, err error) {
+	protos = CompanyProtos{}
+	for _, model := range models {
+		var theProto *Company
+		if theProto, err = model.ToProto(); err != nil {
+			return
+		}
+		theProto = proto.Clone(theProto).(*Company)
+		protos = append(protos, theProto)
+	}
+	return
+}
+
+// CompanyGormModelTable is the name of the Company table
+const CompanyGormModelTable = "companies"
+
 type AddressGormModels []*AddressGormModel
 type AddressProtos []*Address
 type AddressGormModel struct {
@@ -793,7 +1464,16 @@ type AddressGormModel struct {
 	User *UserGormModel `gorm:"foreignKey:UserId;references:Id;constraint:OnDelete:CASCADE;" json:"user" fake:"skip"`
 
 	// @gotags: fake:"skip"
-	CompanyBlob gorm_jsonb.JSONB `gorm:"type:jsonb" json:"companyBlob" fake:"skip"`
+	CompanyBlob gorm_jsonb.JSONB `gorm:"type:jsonb;" json:"companyBlob" fake:"skip"`
+
+	// @gotags: fake:"skip"
+	CompanyBlobs []gorm_jsonb.JSONB `gorm:"type:jsonb;serializer:json;" json:"companyBlobs" fake:"skip"`
+
+	// @gotags: fake:"skip"
+	PrimaryTag gorm_jsonb.JSONB `gorm:"type:jsonb;" json:"primaryTag" fake:"skip"`
+
+	// @gotags: fake:"skip"
+	Tags []gorm_jsonb.JSONB `gorm:"type:jsonb;serializer:json;" json:"tags" fake:"skip"`
 }
 
 func (m *AddressGormModel) TableName() string {
@@ -805,7 +1485,7 @@ func (m AddressGormModels) ToProtos() (protos AddressProtos, err error) {
 	for _, model := range m {
 		var proto *Address
 		if proto, err = model.ToProto(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		protos = append(protos, proto)
 	}
@@ -817,7 +1497,7 @@ func (p AddressProtos) ToModels() (models AddressGormModels, err error) {
 	for _, proto := range p {
 		var model *AddressGormModel
 		if model, err = proto.ToModel(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		models = append(models, model)
 	}
@@ -849,15 +1529,41 @@ func (m *AddressGormModel) ToProto() (theProto *Address, err error) {
 	}
 
 	if m.CompanyBlob != nil {
-		var jsonBytes []byte
-		if jsonBytes, err = json.Marshal(m.CompanyBlob); err != nil {
+		theProto.CompanyBlob = &Company{}
+		if err = gormtypes.JSONBToMessage(m.CompanyBlob, theProto.CompanyBlob); err != nil {
 			return
 		}
-		if err = json.Unmarshal(jsonBytes, &theProto.CompanyBlob); err != nil {
+	}
+
+	if len(m.CompanyBlobs) > 0 {
+		theProto.CompanyBlobs = []*Company{}
+		for _, value := range m.CompanyBlobs {
+			item := &Company{}
+			if err = gormtypes.JSONBToMessage(value, item); err != nil {
+				return
+			}
+			theProto.CompanyBlobs = append(theProto.CompanyBlobs, item)
+		}
+	}
+
+	if m.PrimaryTag != nil {
+		theProto.PrimaryTag = &Tag{}
+		if err = gormtypes.JSONBToMessage(m.PrimaryTag, theProto.PrimaryTag); err != nil {
 			return
 		}
 	}
 
+	if len(m.Tags) > 0 {
+		theProto.Tags = []*Tag{}
+		for _, value := range m.Tags {
+			item := &Tag{}
+			if err = gormtypes.JSONBToMessage(value, item); err != nil {
+				return
+			}
+			theProto.Tags = append(theProto.Tags, item)
+		}
+	}
+
 	return
 }
 
@@ -914,19 +1620,45 @@ func (p *Address) ToModel() (theModel *AddressGormModel, err error) {
 	}
 
 	if p.CompanyBlob != nil {
-		var jsonBytes []byte
-		if jsonBytes, err = json.Marshal(p.CompanyBlob); err != nil {
+		if theModel.CompanyBlob, err = gormtypes.MessageToJSONB(p.CompanyBlob, protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}); err != nil {
 			return
 		}
-		if err = json.Unmarshal(jsonBytes, &theModel.CompanyBlob); err != nil {
+	}
+
+	if len(p.CompanyBlobs) > 0 {
+		theModel.CompanyBlobs = []gorm_jsonb.JSONB{}
+		for _, item := range p.CompanyBlobs {
+			var value gorm_jsonb.JSONB
+			if value, err = gormtypes.MessageToJSONB(item, protojson.MarshalOptions{}); err != nil {
+				return
+			}
+			theModel.CompanyBlobs = append(theModel.CompanyBlobs, value)
+		}
+	}
+
+	if p.PrimaryTag != nil {
+		if theModel.PrimaryTag, err = gormtypes.MessageToJSONB(p.PrimaryTag, protojson.MarshalOptions{}); err != nil {
 			return
 		}
 	}
 
+	if len(p.Tags) > 0 {
+		theModel.Tags = []gorm_jsonb.JSONB{}
+		for _, item := range p.Tags {
+			var value gorm_jsonb.JSONB
+			if value, err = gormtypes.MessageToJSONB(item, protojson.MarshalOptions{}); err != nil {
+				return
+			}
+			theModel.Tags = append(theModel.Tags, value)
+		}
+	}
+
 	return
 }
 
 func (m AddressGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	ids := []string{}
 	for _, model := range m {
 		if model.Id != nil {
@@ -938,7 +1670,8 @@ func (m AddressGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, prelo
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		err = statement.Where("id in ?", ids).Find(&m).Error
+		statement = statement.Where("id in ?", ids)
+		err = statement.Find(&m).Error
 	}
 	return
 }
@@ -946,6 +1679,8 @@ func (m AddressGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, prelo
 // Upsert creates the protos using an on conflict clause to do updates. This function does not update *any* associations
 // use gorm's association mode functions to update associations as you see fit after calling upsert. See https://gorm.io/docs/associations.html#Replace-Associations
 func (p *AddressProtos) Upsert(ctx context.Context, tx *gorm.DB) (models AddressGormModels, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		for _, proto := range *p {
 			if proto.Id == nil {
@@ -958,19 +1693,24 @@ func (p *AddressProtos) Upsert(ctx context.Context, tx *gorm.DB) (models Address
 		}
 		// create new session so the tx isn't modified
 		session := tx.Session(&gorm.Session{})
-		err = session.
+		_, err = CreateInBatches(session.
 			// on conflict, update all fields
 			Clauses(clause.OnConflict{
 				UpdateAll: true,
 			}).
 			// exclude associations from upsert
-			Omit(clause.Associations).
-			Create(&models).Error
+			Omit(clause.Associations), models)
+		if err == nil {
+			// update the protos with the models, which are the rows as stored
+			err = RefreshProtos(*p, models)
+		}
 	}
 	return
 }
 
 func (p *AddressProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int, order interface{}, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models AddressGormModels
 		statement := tx.Preload(clause.Associations).Limit(limit).Offset(offset)
@@ -993,13 +1733,16 @@ func (p *AddressProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int
 }
 
 func (p *AddressProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models AddressGormModels
 		statement := tx.Preload(clause.Associations)
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		if err = statement.Where("id in ?", ids).Find(&models).Error; err != nil {
+		statement = statement.Where("id in ?", ids)
+		if err = statement.Find(&models).Error; err != nil {
 			return
 		}
 		if len(models) > 0 {
@@ -1011,11 +1754,189 @@ func (p *AddressProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string,
 	return
 }
 
-func DeleteAddressGormModels(ctx context.Context, tx *gorm.DB, ids []string) error {
-	statement := tx.Where("id in ?", ids)
-	return statement.Delete(&AddressGormModel{}).Error
+// DeleteAddressGormModels deletes the models with the given ids, returning the deleted models and protos
+func DeleteAddressGormModels(ctx context.Context, tx *gorm.DB, ids []string) (models AddressGormModels, protos AddressProtos, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
+	remove := func(tx *gorm.DB) (err error) {
+		statement := tx.Where("id in ?", ids)
+		models, err = DeleteReturning[*AddressGormModel](statement)
+		return
+	}
+	if err = remove(tx); err != nil {
+		return
+	}
+	protos, err = models.ToProtos()
+	return
+}
+
+// AddressRepository reads and writes Addresss, so services can depend on it rather than on
+// gorm. AddressGormRepository is the gorm implementation
+type AddressRepository interface {
+	GetByIds(ctx context.Context, ids []string, preloads ...string) (AddressProtos, error)
+	List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (AddressProtos, error)
+	// Upsert creates or updates the protos, without their associations, and returns them with their ids set
+	Upsert(ctx context.Context, protos AddressProtos) (AddressProtos, error)
+	// Delete deletes the rows of the ids, and returns the deleted rows without their associations
+	Delete(ctx context.Context, ids []string) (AddressProtos, error)
+}
+
+// AddressGormRepository is the AddressRepository backed by the generated gorm functions, its db may be a transaction
+type AddressGormRepository struct {
+	db *gorm.DB
+}
+
+var _ AddressRepository = &AddressGormRepository{}
+
+func NewAddressGormRepository(db *gorm.DB) *AddressGormRepository {
+	return &AddressGormRepository{db: db}
+}
+
+func (r *AddressGormRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (protos AddressProtos, err error) {
+	err = protos.GetByIds(ctx, r.db.WithContext(ctx), ids, preloads...)
+	return
+}
+
+func (r *AddressGormRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (protos AddressProtos, err error) {
+	err = protos.List(ctx, r.db.WithContext(ctx), limit, offset, order, preloads...)
+	return
+}
+
+func (r *AddressGormRepository) Upsert(ctx context.Context, protos AddressProtos) (AddressProtos, error) {
+	_, err := protos.Upsert(ctx, r.db.WithContext(ctx))
+	return protos, err
+}
+
+func (r *AddressGormRepository) Delete(ctx context.Context, ids []string) (AddressProtos, error) {
+	_, protos, err := DeleteAddressGormModels(ctx, r.db.WithContext(ctx), ids)
+	return protos, err
+}
+
+// AddressFakeRepository is an in memory AddressRepository for tests. Like the gorm repository, upserts
+// set missing ids and timestamps without saving associations, and reads load the associations to messages of the same
+// go package one level deep
+type AddressFakeRepository struct {
+	store *FakeStore
 }
 
+var _ AddressRepository = &AddressFakeRepository{}
+
+func NewAddressFakeRepository(store *FakeStore) *AddressFakeRepository {
+	return &AddressFakeRepository{store: store}
+}
+
+// AddressFakeColumns are the columns fake repositories can order Addresss by
+var AddressFakeColumns = map[string]func(*AddressGormModel) interface{}{
+	"id":         func(model *AddressGormModel) interface{} { return model.Id },
+	"created_at": func(model *AddressGormModel) interface{} { return model.CreatedAt },
+	"updated_at": func(model *AddressGormModel) interface{} { return model.UpdatedAt },
+	"name":       func(model *AddressGormModel) interface{} { return model.Name },
+	"user_id":    func(model *AddressGormModel) interface{} { return model.UserId },
+}
+
+func (r *AddressFakeRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (AddressProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*AddressGormModel{}
+	for _, id := range lo.Uniq(ids) {
+		if row, ok := r.store.tables["addresses"][id]; ok {
+			models = append(models, row.(*AddressGormModel))
+		}
+	}
+	return r.toProtos(models)
+}
+
+func (r *AddressFakeRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (AddressProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*AddressGormModel{}
+	for _, row := range r.store.tables["addresses"] {
+		models = append(models, row.(*AddressGormModel))
+	}
+	if err := fakeOrder(models, order, AddressFakeColumns); err != nil {
+		return nil, err
+	}
+	return r.toProtos(fakePage(models, limit, offset))
+}
+
+func (r *AddressFakeRepository) Upsert(ctx context.Context, protos AddressProtos) (AddressProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	stored := AddressProtos{}
+	for _, theProto := range protos {
+		if theProto.Id == nil {
+			theProto.Id = lo.ToPtr(uuid.New().String())
+		}
+		stored = append(stored, proto.Clone(theProto).(*Address))
+	}
+	models, err := stored.ToModels()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	for _, model := range models {
+		existing, exists := r.store.tables["addresses"][*model.Id].(*AddressGormModel)
+		model.User = nil
+		if exists {
+			model.CreatedAt = existing.CreatedAt
+		} else if model.CreatedAt == nil {
+			model.CreatedAt = &now
+		}
+		if model.UpdatedAt == nil {
+			model.UpdatedAt = &now
+		}
+		r.store.tables["addresses"][*model.Id] = model
+	}
+	// like the gorm upsert, the protos are updated with the rows as stored
+	if stored, err = r.toProtos(models); err != nil {
+		return nil, err
+	}
+	for i, theProto := range protos {
+		proto.Reset(theProto)
+		proto.Merge(theProto, stored[i])
+	}
+	return protos, nil
+}
+
+func (r *AddressFakeRepository) Delete(ctx context.Context, ids []string) (AddressProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	models := AddressGormModels{}
+	for _, id := range ids {
+		row, ok := r.store.tables["addresses"][id]
+		if !ok {
+			continue
+		}
+		models = append(models, row.(*AddressGormModel))
+		delete(r.store.tables["addresses"], id)
+		r.store.deleteAssociations("addresses", id)
+	}
+	// like the gorm delete, the deleted rows are returned without their associations
+	return models.ToProtos()
+}
+
+// toProtos converts the stored models, and loads their associations from the store
+func (r *AddressFakeRepository) toProtos(models []*AddressGormModel) (protos AddressProtos, err error) {
+	protos = AddressProtos{}
+	for _, model := range models {
+		var theProto *Address
+		if theProto, err = model.ToProto(); err != nil {
+			return
+		}
+		theProto = proto.Clone(theProto).(*Address)
+		if row, ok := r.store.tables["users"][lo.FromPtr(model.UserId)]; ok {
+			if theProto.User, err = row.(*UserGormModel).ToProto(); err != nil {
+				return
+			}
+		}
+		protos = append(protos, theProto)
+	}
+	return
+}
+
+// AddressGormModelTable is the name of the Address table
+const AddressGormModelTable = "addresses"
+
 type CommentGormModels []*CommentGormModel
 type CommentProtos []*Comment
 type CommentGormModel struct {
@@ -1048,7 +1969,7 @@ func (m CommentGormModels) ToProtos() (protos CommentProtos, err error) {
 	for _, model := range m {
 		var proto *Comment
 		if proto, err = model.ToProto(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		protos = append(protos, proto)
 	}
@@ -1060,7 +1981,7 @@ func (p CommentProtos) ToModels() (models CommentGormModels, err error) {
 	for _, proto := range p {
 		var model *CommentGormModel
 		if model, err = proto.ToModel(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		models = append(models, model)
 	}
@@ -1150,6 +2071,8 @@ func (p *Comment) ToModel() (theModel *CommentGormModel, err error) {
 }
 
 func (m CommentGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	ids := []string{}
 	for _, model := range m {
 		if model.Id != nil {
@@ -1161,7 +2084,8 @@ func (m CommentGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, prelo
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		err = statement.Where("id in ?", ids).Find(&m).Error
+		statement = statement.Where("id in ?", ids)
+		err = statement.Find(&m).Error
 	}
 	return
 }
@@ -1169,6 +2093,8 @@ func (m CommentGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, prelo
 // Upsert creates the protos using an on conflict clause to do updates. This function does not update *any* associations
 // use gorm's association mode functions to update associations as you see fit after calling upsert. See https://gorm.io/docs/associations.html#Replace-Associations
 func (p *CommentProtos) Upsert(ctx context.Context, tx *gorm.DB) (models CommentGormModels, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		for _, proto := range *p {
 			if proto.Id == nil {
@@ -1181,19 +2107,24 @@ func (p *CommentProtos) Upsert(ctx context.Context, tx *gorm.DB) (models Comment
 		}
 		// create new session so the tx isn't modified
 		session := tx.Session(&gorm.Session{})
-		err = session.
+		_, err = CreateInBatches(session.
 			// on conflict, update all fields
 			Clauses(clause.OnConflict{
 				UpdateAll: true,
 			}).
 			// exclude associations from upsert
-			Omit(clause.Associations).
-			Create(&models).Error
+			Omit(clause.Associations), models)
+		if err == nil {
+			// update the protos with the models, which are the rows as stored
+			err = RefreshProtos(*p, models)
+		}
 	}
 	return
 }
 
 func (p *CommentProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int, order interface{}, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models CommentGormModels
 		statement := tx.Preload(clause.Associations).Limit(limit).Offset(offset)
@@ -1216,13 +2147,16 @@ func (p *CommentProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int
 }
 
 func (p *CommentProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models CommentGormModels
 		statement := tx.Preload(clause.Associations)
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		if err = statement.Where("id in ?", ids).Find(&models).Error; err != nil {
+		statement = statement.Where("id in ?", ids)
+		if err = statement.Find(&models).Error; err != nil {
 			return
 		}
 		if len(models) > 0 {
@@ -1234,11 +2168,189 @@ func (p *CommentProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string,
 	return
 }
 
-func DeleteCommentGormModels(ctx context.Context, tx *gorm.DB, ids []string) error {
-	statement := tx.Where("id in ?", ids)
-	return statement.Delete(&CommentGormModel{}).Error
+// DeleteCommentGormModels deletes the models with the given ids, returning the deleted models and protos
+func DeleteCommentGormModels(ctx context.Context, tx *gorm.DB, ids []string) (models CommentGormModels, protos CommentProtos, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
+	remove := func(tx *gorm.DB) (err error) {
+		statement := tx.Where("id in ?", ids)
+		models, err = DeleteReturning[*CommentGormModel](statement)
+		return
+	}
+	if err = remove(tx); err != nil {
+		return
+	}
+	protos, err = models.ToProtos()
+	return
+}
+
+// CommentRepository reads and writes Comments, so services can depend on it rather than on
+// gorm. CommentGormRepository is the gorm implementation
+type CommentRepository interface {
+	GetByIds(ctx context.Context, ids []string, preloads ...string) (CommentProtos, error)
+	List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (CommentProtos, error)
+	// Upsert creates or updates the protos, without their associations, and returns them with their ids set
+	Upsert(ctx context.Context, protos CommentProtos) (CommentProtos, error)
+	// Delete deletes the rows of the ids, and returns the deleted rows without their associations
+	Delete(ctx context.Context, ids []string) (CommentProtos, error)
+}
+
+// CommentGormRepository is the CommentRepository backed by the generated gorm functions, its db may be a transaction
+type CommentGormRepository struct {
+	db *gorm.DB
+}
+
+var _ CommentRepository = &CommentGormRepository{}
+
+func NewCommentGormRepository(db *gorm.DB) *CommentGormRepository {
+	return &CommentGormRepository{db: db}
+}
+
+func (r *CommentGormRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (protos CommentProtos, err error) {
+	err = protos.GetByIds(ctx, r.db.WithContext(ctx), ids, preloads...)
+	return
+}
+
+func (r *CommentGormRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (protos CommentProtos, err error) {
+	err = protos.List(ctx, r.db.WithContext(ctx), limit, offset, order, preloads...)
+	return
+}
+
+func (r *CommentGormRepository) Upsert(ctx context.Context, protos CommentProtos) (CommentProtos, error) {
+	_, err := protos.Upsert(ctx, r.db.WithContext(ctx))
+	return protos, err
+}
+
+func (r *CommentGormRepository) Delete(ctx context.Context, ids []string) (CommentProtos, error) {
+	_, protos, err := DeleteCommentGormModels(ctx, r.db.WithContext(ctx), ids)
+	return protos, err
+}
+
+// CommentFakeRepository is an in memory CommentRepository for tests. Like the gorm repository, upserts
+// set missing ids and timestamps without saving associations, and reads load the associations to messages of the same
+// go package one level deep
+type CommentFakeRepository struct {
+	store *FakeStore
+}
+
+var _ CommentRepository = &CommentFakeRepository{}
+
+func NewCommentFakeRepository(store *FakeStore) *CommentFakeRepository {
+	return &CommentFakeRepository{store: store}
+}
+
+// CommentFakeColumns are the columns fake repositories can order Comments by
+var CommentFakeColumns = map[string]func(*CommentGormModel) interface{}{
+	"id":         func(model *CommentGormModel) interface{} { return model.Id },
+	"created_at": func(model *CommentGormModel) interface{} { return model.CreatedAt },
+	"updated_at": func(model *CommentGormModel) interface{} { return model.UpdatedAt },
+	"name":       func(model *CommentGormModel) interface{} { return model.Name },
+	"user_id":    func(model *CommentGormModel) interface{} { return model.UserId },
+}
+
+func (r *CommentFakeRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (CommentProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*CommentGormModel{}
+	for _, id := range lo.Uniq(ids) {
+		if row, ok := r.store.tables["comments"][id]; ok {
+			models = append(models, row.(*CommentGormModel))
+		}
+	}
+	return r.toProtos(models)
 }
 
+func (r *CommentFakeRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (CommentProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*CommentGormModel{}
+	for _, row := range r.store.tables["comments"] {
+		models = append(models, row.(*CommentGormModel))
+	}
+	if err := fakeOrder(models, order, CommentFakeColumns); err != nil {
+		return nil, err
+	}
+	return r.toProtos(fakePage(models, limit, offset))
+}
+
+func (r *CommentFakeRepository) Upsert(ctx context.Context, protos CommentProtos) (CommentProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	stored := CommentProtos{}
+	for _, theProto := range protos {
+		if theProto.Id == nil {
+			theProto.Id = lo.ToPtr(uuid.New().String())
+		}
+		stored = append(stored, proto.Clone(theProto).(*Comment))
+	}
+	models, err := stored.ToModels()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	for _, model := range models {
+		existing, exists := r.store.tables["comments"][*model.Id].(*CommentGormModel)
+		model.User = nil
+		if exists {
+			model.CreatedAt = existing.CreatedAt
+		} else if model.CreatedAt == nil {
+			model.CreatedAt = &now
+		}
+		if model.UpdatedAt == nil {
+			model.UpdatedAt = &now
+		}
+		r.store.tables["comments"][*model.Id] = model
+	}
+	// like the gorm upsert, the protos are updated with the rows as stored
+	if stored, err = r.toProtos(models); err != nil {
+		return nil, err
+	}
+	for i, theProto := range protos {
+		proto.Reset(theProto)
+		proto.Merge(theProto, stored[i])
+	}
+	return protos, nil
+}
+
+func (r *CommentFakeRepository) Delete(ctx context.Context, ids []string) (CommentProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	models := CommentGormModels{}
+	for _, id := range ids {
+		row, ok := r.store.tables["comments"][id]
+		if !ok {
+			continue
+		}
+		models = append(models, row.(*CommentGormModel))
+		delete(r.store.tables["comments"], id)
+		r.store.deleteAssociations("comments", id)
+	}
+	// like the gorm delete, the deleted rows are returned without their associations
+	return models.ToProtos()
+}
+
+// toProtos converts the stored models, and loads their associations from the store
+func (r *CommentFakeRepository) toProtos(models []*CommentGormModel) (protos CommentProtos, err error) {
+	protos = CommentProtos{}
+	for _, model := range models {
+		var theProto *Comment
+		if theProto, err = model.ToProto(); err != nil {
+			return
+		}
+		theProto = proto.Clone(theProto).(*Comment)
+		if row, ok := r.store.tables["users"][lo.FromPtr(model.UserId)]; ok {
+			if theProto.User, err = row.(*UserGormModel).ToProto(); err != nil {
+				return
+			}
+		}
+		protos = append(protos, theProto)
+	}
+	return
+}
+
+// CommentGormModelTable is the name of the Comment table
+const CommentGormModelTable = "comments"
+
 type ProfileGormModels []*ProfileGormModel
 type ProfileProtos []*Profile
 type ProfileGormModel struct {
@@ -1265,7 +2377,7 @@ func (m ProfileGormModels) ToProtos() (protos ProfileProtos, err error) {
 	for _, model := range m {
 		var proto *Profile
 		if proto, err = model.ToProto(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		protos = append(protos, proto)
 	}
@@ -1277,7 +2389,7 @@ func (p ProfileProtos) ToModels() (models ProfileGormModels, err error) {
 	for _, proto := range p {
 		var model *ProfileGormModel
 		if model, err = proto.ToModel(); err != nil {
-			return
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		models = append(models, model)
 	}
@@ -1350,6 +2462,8 @@ func (p *Profile) ToModel() (theModel *ProfileGormModel, err error) {
 }
 
 func (m ProfileGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	ids := []string{}
 	for _, model := range m {
 		if model.Id != nil {
@@ -1361,7 +2475,8 @@ func (m ProfileGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, prelo
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		err = statement.Where("id in ?", ids).Find(&m).Error
+		statement = statement.Where("id in ?", ids)
+		err = statement.Find(&m).Error
 	}
 	return
 }
@@ -1369,6 +2484,8 @@ func (m ProfileGormModels) GetByModelIds(ctx context.Context, tx *gorm.DB, prelo
 // Upsert creates the protos using an on conflict clause to do updates. This function does not update *any* associations
 // use gorm's association mode functions to update associations as you see fit after calling upsert. See https://gorm.io/docs/associations.html#Replace-Associations
 func (p *ProfileProtos) Upsert(ctx context.Context, tx *gorm.DB) (models ProfileGormModels, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		for _, proto := range *p {
 			if proto.Id == nil {
@@ -1381,19 +2498,24 @@ func (p *ProfileProtos) Upsert(ctx context.Context, tx *gorm.DB) (models Profile
 		}
 		// create new session so the tx isn't modified
 		session := tx.Session(&gorm.Session{})
-		err = session.
+		_, err = CreateInBatches(session.
 			// on conflict, update all fields
 			Clauses(clause.OnConflict{
 				UpdateAll: true,
 			}).
 			// exclude associations from upsert
-			Omit(clause.Associations).
-			Create(&models).Error
+			Omit(clause.Associations), models)
+		if err == nil {
+			// update the protos with the models, which are the rows as stored
+			err = RefreshProtos(*p, models)
+		}
 	}
 	return
 }
 
 func (p *ProfileProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int, order interface{}, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models ProfileGormModels
 		statement := tx.Preload(clause.Associations).Limit(limit).Offset(offset)
@@ -1416,13 +2538,16 @@ func (p *ProfileProtos) List(ctx context.Context, tx *gorm.DB, limit, offset int
 }
 
 func (p *ProfileProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string, preloads ...string) (err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models ProfileGormModels
 		statement := tx.Preload(clause.Associations)
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		if err = statement.Where("id in ?", ids).Find(&models).Error; err != nil {
+		statement = statement.Where("id in ?", ids)
+		if err = statement.Find(&models).Error; err != nil {
 			return
 		}
 		if len(models) > 0 {
@@ -1434,273 +2559,178 @@ func (p *ProfileProtos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string,
 	return
 }
 
-func DeleteProfileGormModels(ctx context.Context, tx *gorm.DB, ids []string) error {
-	statement := tx.Where("id in ?", ids)
-	return statement.Delete(&ProfileGormModel{}).Error
+// DeleteProfileGormModels deletes the models with the given ids, returning the deleted models and protos
+func DeleteProfileGormModels(ctx context.Context, tx *gorm.DB, ids []string) (models ProfileGormModels, protos ProfileProtos, err error) {
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
+	remove := func(tx *gorm.DB) (err error) {
+		statement := tx.Where("id in ?", ids)
+		models, err = DeleteReturning[*ProfileGormModel](statement)
+		return
+	}
+	if err = remove(tx); err != nil {
+		return
+	}
+	protos, err = models.ToProtos()
+	return
 }
 
-// Protos is a union of other types that defines which types may be used in generic functions
-type Protos interface {
-	*User | *Company | *Address | *Comment | *Profile
-	GetProtoId() *string
-	SetProtoId(string)
+// ProfileRepository reads and writes Profiles, so services can depend on it rather than on
+// gorm. ProfileGormRepository is the gorm implementation
+type ProfileRepository interface {
+	GetByIds(ctx context.Context, ids []string, preloads ...string) (ProfileProtos, error)
+	List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (ProfileProtos, error)
+	// Upsert creates or updates the protos, without their associations, and returns them with their ids set
+	Upsert(ctx context.Context, protos ProfileProtos) (ProfileProtos, error)
+	// Delete deletes the rows of the ids, and returns the deleted rows without their associations
+	Delete(ctx context.Context, ids []string) (ProfileProtos, error)
 }
 
-// Models is a union of other types that defines which types may be used in generic functions
-type Models interface {
-	*UserGormModel | *CompanyGormModel | *AddressGormModel | *CommentGormModel | *ProfileGormModel
-	GetModelId() *string
-	SetModelId(string)
-	New() interface{}
-	TableName() string // tabler interface for gorm model, gives us access to the table name that gorm will use, see https://gorm.io/docs/conventions.html#TableName
+// ProfileGormRepository is the ProfileRepository backed by the generated gorm functions, its db may be a transaction
+type ProfileGormRepository struct {
+	db *gorm.DB
 }
 
-// Proto[M Models] is an interface type that defines behavior for the implementer of a given Models type
-type Proto[M Models] interface {
-	GetProtoId() *string
-	SetProtoId(string)
-	ToModel() (M, error)
+var _ ProfileRepository = &ProfileGormRepository{}
+
+func NewProfileGormRepository(db *gorm.DB) *ProfileGormRepository {
+	return &ProfileGormRepository{db: db}
 }
 
-// Model[P Protos] is an interface type that defines behavior for the implementer of a given Protos type
-type Model[P Protos] interface {
-	ToProto() (P, error)
+func (r *ProfileGormRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (protos ProfileProtos, err error) {
+	err = protos.GetByIds(ctx, r.db.WithContext(ctx), ids, preloads...)
+	return
 }
 
-// ToModels converts an array of protos to an array of gorm db models by calling the proto's ToModel method
-func ToModels[P Protos, M Models](protos interface{}) ([]M, error) {
-	converted := ConvertProtosToProtosM[P, M](protos)
-	models := []M{}
-	for _, proto := range converted {
-		model, err := proto.ToModel()
-		if err != nil {
-			return nil, err
-		}
-		models = append(models, model)
-	}
-	return models, nil
+func (r *ProfileGormRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (protos ProfileProtos, err error) {
+	err = protos.List(ctx, r.db.WithContext(ctx), limit, offset, order, preloads...)
+	return
 }
 
-// ConvertProtosToProtosM converts a given slice of protos into an array of the Proto interface type, which can then
-// leverage the interface methods
-func ConvertProtosToProtosM[P Protos, M Models](protos interface{}) []Proto[M] {
-	assertedProtos := protos.([]P)
-	things := make([]Proto[M], len(assertedProtos))
-	for i, v := range assertedProtos {
-		things[i] = ConvertProtoToProtosM[P, M](v)
-	}
-	return things
+func (r *ProfileGormRepository) Upsert(ctx context.Context, protos ProfileProtos) (ProfileProtos, error) {
+	_, err := protos.Upsert(ctx, r.db.WithContext(ctx))
+	return protos, err
 }
 
-// ConvertProtoToProtosM converts a single proto to a Proto[M]
-func ConvertProtoToProtosM[P Protos, M Models](proto interface{}) Proto[M] {
-	return any(proto).(Proto[M])
+func (r *ProfileGormRepository) Delete(ctx context.Context, ids []string) (ProfileProtos, error) {
+	_, protos, err := DeleteProfileGormModels(ctx, r.db.WithContext(ctx), ids)
+	return protos, err
 }
 
-// ConvertProtosToProtosM converts a given slice of protos into an array of the Proto interface type, which can then
-// leverage the interface methods
-func ConvertModelsToModelsP[P Protos, M Models](models interface{}) []Model[P] {
-	assertedModels := models.([]M)
-	things := make([]Model[P], len(assertedModels))
-	for i, v := range assertedModels {
-		things[i] = ConvertModelToModelP[P, M](v)
-	}
-	return things
+// ProfileFakeRepository is an in memory ProfileRepository for tests. Like the gorm repository, upserts
+// set missing ids and timestamps without saving associations, and reads load the associations to messages of the same
+// go package one level deep
+type ProfileFakeRepository struct {
+	store *FakeStore
 }
 
-// ConvertProtoToProtosM converts a single proto to a Proto[M]
-func ConvertModelToModelP[P Protos, M Models](model interface{}) Model[P] {
-	return any(model).(Model[P])
+var _ ProfileRepository = &ProfileFakeRepository{}
+
+func NewProfileFakeRepository(store *FakeStore) *ProfileFakeRepository {
+	return &ProfileFakeRepository{store: store}
 }
 
-// ToProtos converts an array of models into an array of protos by calling the model's ToProto method
-func ToProtos[P Protos, M Models](models interface{}) ([]P, error) {
-	converted := ConvertModelsToModelsP[P, M](models)
-	protos := []P{}
-	for _, model := range converted {
-		proto, err := model.ToProto()
-		if err != nil {
-			return nil, err
-		}
-		protos = append(protos, proto)
-	}
-	return protos, nil
+// ProfileFakeColumns are the columns fake repositories can order Profiles by
+var ProfileFakeColumns = map[string]func(*ProfileGormModel) interface{}{
+	"id":         func(model *ProfileGormModel) interface{} { return model.Id },
+	"created_at": func(model *ProfileGormModel) interface{} { return model.CreatedAt },
+	"updated_at": func(model *ProfileGormModel) interface{} { return model.UpdatedAt },
+	"name":       func(model *ProfileGormModel) interface{} { return model.Name },
 }
 
-// Upsert is a generic function that will upsert any of the generated protos, returning the upserted models. Upsert
-// excludes all associations, and uses an on conflict clause to handle upsert. A function may be provided to be executed
-// during the transaction. The function is executed after the upsert. If the function returns an error, the transaction
-// will be rolled back.
-func Upsert[P Protos, M Models](ctx context.Context, db *gorm.DB, protos interface{}) ([]M, error) {
-	converted := ConvertProtosToProtosM[P, M](protos)
-	if len(converted) > 0 {
-		models := []M{}
-		for _, proto := range converted {
-			if proto.GetProtoId() == nil {
-				proto.SetProtoId(uuid.New().String())
-			}
-			model, err := proto.ToModel()
-			if err != nil {
-				return nil, err
-			}
-			models = append(models, model)
+func (r *ProfileFakeRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (ProfileProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*ProfileGormModel{}
+	for _, id := range lo.Uniq(ids) {
+		if row, ok := r.store.tables["profiles"][id]; ok {
+			models = append(models, row.(*ProfileGormModel))
 		}
-		session := db.Session(&gorm.Session{})
-		err := session.
-			// on conflict, update all fields
-			Clauses(clause.OnConflict{
-				UpdateAll: true,
-			}).
-			// exclude associations from upsert
-			Omit(clause.Associations).
-			Create(&models).Error
-
-		return models, err
 	}
-	return nil, nil
+	return r.toProtos(models)
 }
 
-// Delete is a generic function that will delete any of the generated protos. A function may be provided to be executed
-// during the transaction. The function is executed after the delete. If the function returns an error, the transaction
-// will be rolled back.
-func Delete[M Models](ctx context.Context, db *gorm.DB, ids []string) ([]M, error) {
-	if len(ids) > 0 {
-		session := db.Session(&gorm.Session{})
-		models := []M{}
-		err := session.Where("id in ?", ids).Delete(&models).Error
-		return models, err
+func (r *ProfileFakeRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (ProfileProtos, error) {
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*ProfileGormModel{}
+	for _, row := range r.store.tables["profiles"] {
+		models = append(models, row.(*ProfileGormModel))
 	}
-	return nil, nil
+	if err := fakeOrder(models, order, ProfileFakeColumns); err != nil {
+		return nil, err
+	}
+	return r.toProtos(fakePage(models, limit, offset))
 }
 
-// List lists the given model type
-func List[M Models](ctx context.Context, db *gorm.DB, limit, offset int, orderBy string, preloads map[string][]interface{}) ([]M, error) {
-	session := db.Session(&gorm.Session{}).WithContext(ctx)
-	// set limit
-	if limit > 0 {
-		session = session.Limit(limit)
+func (r *ProfileFakeRepository) Upsert(ctx context.Context, protos ProfileProtos) (ProfileProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	stored := ProfileProtos{}
+	for _, theProto := range protos {
+		if theProto.Id == nil {
+			theProto.Id = lo.ToPtr(uuid.New().String())
+		}
+		stored = append(stored, proto.Clone(theProto).(*Profile))
 	}
-	// set offset
-	if offset > 0 {
-		session = session.Offset(offset)
+	models, err := stored.ToModels()
+	if err != nil {
+		return nil, err
 	}
-	// set preloads
-	for preload, args := range preloads {
-		session = session.Preload(preload, args...)
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	for _, model := range models {
+		existing, exists := r.store.tables["profiles"][*model.Id].(*ProfileGormModel)
+		if exists {
+			model.CreatedAt = existing.CreatedAt
+		} else if model.CreatedAt == nil {
+			model.CreatedAt = &now
+		}
+		if model.UpdatedAt == nil {
+			model.UpdatedAt = &now
+		}
+		r.store.tables["profiles"][*model.Id] = model
 	}
-	// set order by
-	if orderBy != "" {
-		session = session.Order(orderBy)
+	// like the gorm upsert, the protos are updated with the rows as stored
+	if stored, err = r.toProtos(models); err != nil {
+		return nil, err
 	}
-	// execute
-	var models []M
-	err := session.Find(&models).Error
-	return models, err
-}
-
-// GetByIds gets the given model type by id
-func GetByIds[M Models](ctx context.Context, db *gorm.DB, ids []string, preloads map[string][]interface{}) ([]M, error) {
-	session := db.Session(&gorm.Session{}).WithContext(ctx)
-	// set preloads
-	for preload, args := range preloads {
-		session = session.Preload(preload, args...)
-	}
-	models := []M{}
-	err := session.Where("id in ?", ids).Find(&models).Error
-	return models, err
-}
-
-// ManyToManyAssociations is a sync map with helper functions. I'm using a sync.map so that it's thread safe, and
-// a struct to allow us to easily define behavior we can use elsewhere
-type ManyToManyAssociations struct {
-	data sync.Map
-}
-
-func (m *ManyToManyAssociations) Associations() map[string][]string {
-	associations := map[string][]string{}
-	m.data.Range(func(key, value any) bool {
-		associations[key.(string)] = value.([]string)
-		return true
-	})
-	return associations
-}
-
-func (m *ManyToManyAssociations) AddAssociation(modelId, associatedId string) {
-	var associations []string
-	val, ok := m.data.Load(modelId)
-	if ok {
-		associations = val.([]string)
-		associations = append(associations, associatedId)
-	} else {
-		associations = []string{associatedId}
+	for i, theProto := range protos {
+		proto.Reset(theProto)
+		proto.Merge(theProto, stored[i])
 	}
-	m.data.Store(modelId, associations)
+	return protos, nil
 }
 
-func ReplaceManyToMany[L Models, R Models](ctx context.Context, db *gorm.DB, associations *ManyToManyAssociations, associationName string) error {
-	session := db.Session(&gorm.Session{})
-	session = session.Clauses(clause.OnConflict{DoNothing: true})
-	for id, associatedIds := range associations.Associations() {
-		var associations []R
-		var temp L
-		model := temp.New().(L)
-		model.SetModelId(id)
-		for _, id := range associatedIds {
-			var associatedTemp R
-			associatedModel := associatedTemp.New().(R)
-			associatedModel.SetModelId(id)
-			associations = append(associations, associatedModel)
-		}
-		// omit is required otherwise it generates some weird sql and tries to update other columns that don't exist
-		err := session.Model(&model).Omit("*").Association(associationName).Replace(&associations)
-		if err != nil {
-			return err
+func (r *ProfileFakeRepository) Delete(ctx context.Context, ids []string) (ProfileProtos, error) {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	models := ProfileGormModels{}
+	for _, id := range ids {
+		row, ok := r.store.tables["profiles"][id]
+		if !ok {
+			continue
 		}
+		models = append(models, row.(*ProfileGormModel))
+		delete(r.store.tables["profiles"], id)
+		r.store.deleteAssociations("profiles", id)
 	}
-	return nil
+	// like the gorm delete, the deleted rows are returned without their associations
+	return models.ToProtos()
 }
 
-func AssociateManyToMany[L Models, R Models](ctx context.Context, db *gorm.DB, associations *ManyToManyAssociations, associationName string) error {
-	session := db.Session(&gorm.Session{})
-	session = session.Clauses(clause.OnConflict{DoNothing: true})
-	for id, associatedIds := range associations.Associations() {
-		var associations []R
-		var temp L
-		model := temp.New().(L)
-		model.SetModelId(id)
-		for _, id := range associatedIds {
-			var associatedTemp R
-			associatedModel := associatedTemp.New().(R)
-			associatedModel.SetModelId(id)
-			associations = append(associations, associatedModel)
-		}
-		// omit is required otherwise it generates some weird sql and tries to update other columns that don't exist
-		err := session.Model(&model).Omit("*").Association(associationName).Append(&associations)
-		if err != nil {
-			return err
+// toProtos converts the stored models, and loads their associations from the store
+func (r *ProfileFakeRepository) toProtos(models []*ProfileGormModel) (protos ProfileProtos, err error) {
+	protos = ProfileProtos{}
+	for _, model := range models {
+		var theProto *Profile
+		if theProto, err = model.ToProto(); err != nil {
+			return
 		}
+		theProto = proto.Clone(theProto).(*Profile)
+		protos = append(protos, theProto)
 	}
-	return nil
+	return
 }
 
-func DissociateManyToMany[L Models, R Models](ctx context.Context, db *gorm.DB, associations *ManyToManyAssociations, associationName string) error {
-	session := db.Session(&gorm.Session{})
-	for id, associatedIds := range associations.Associations() {
-		var associations []R
-		var temp L
-		model := temp.New().(L)
-		model.SetModelId(id)
-		for _, id := range associatedIds {
-			var associatedTemp R
-			associatedModel := associatedTemp.New().(R)
-			associatedModel.SetModelId(id)
-			associations = append(associations, associatedModel)
-		}
-		// omit is required otherwise it generates some weird sql and tries to update other columns that don't exist
-		txErr := session.Model(&model).Omit("*").Association(associationName).Delete(&associations)
-		if txErr != nil {
-			return txErr
-		}
-	}
-	return nil
-}
+// ProfileGormModelTable is the name of the Profile table
+const ProfileGormModelTable = "profiles"
@@ -0,0 +1,64 @@
+package customtypes
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"net/netip"
+)
+
+// IPAddr is a netip.Addr that can be stored in an inet column. It's used by the examples to demonstrate the custom_type
+// field option
+type IPAddr struct {
+	netip.Addr
+}
+
+func (a IPAddr) Value() (driver.Value, error) {
+	if !a.IsValid() {
+		return nil, nil
+	}
+	return a.String(), nil
+}
+
+func (a *IPAddr) Scan(src interface{}) (err error) {
+	var text string
+	switch value := src.(type) {
+	case nil:
+		*a = IPAddr{}
+		return nil
+	case string:
+		text = value
+	case []byte:
+		text = string(value)
+	default:
+		return fmt.Errorf("incompatible type %T for IPAddr", src)
+	}
+	// inet values may carry a prefix length, which netip.ParsePrefix handles for both forms
+	prefix, err := netip.ParsePrefix(text)
+	if err != nil {
+		var addr netip.Addr
+		if addr, err = netip.ParseAddr(text); err != nil {
+			return
+		}
+		*a = IPAddr{Addr: addr}
+		return nil
+	}
+	*a = IPAddr{Addr: prefix.Addr()}
+	return nil
+}
+
+// IPAddrFromString converts a proto string field to an IPAddr, an empty string is the zero IPAddr
+func IPAddrFromString(text string) (IPAddr, error) {
+	if text == "" {
+		return IPAddr{}, nil
+	}
+	addr, err := netip.ParseAddr(text)
+	return IPAddr{Addr: addr}, err
+}
+
+// IPAddrToString converts an IPAddr to a proto string field, the zero IPAddr is an empty string
+func IPAddrToString(addr IPAddr) (string, error) {
+	if !addr.IsValid() {
+		return "", nil
+	}
+	return addr.String(), nil
+}
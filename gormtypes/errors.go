@@ -0,0 +1,186 @@
+package gormtypes
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// The kinds of the typed errors ClassifyError returns, errors.Is matches the typed errors of a kind with them
+var (
+	// ErrNotFound is the kind of NotFoundError
+	ErrNotFound = errors.New("not found")
+	// ErrUniqueViolation is the kind of UniqueViolationError
+	ErrUniqueViolation = errors.New("unique violation")
+	// ErrForeignKeyViolation is the kind of ForeignKeyViolationError
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	// ErrCheckViolation is the kind of CheckViolationError
+	ErrCheckViolation = errors.New("check violation")
+	// ErrSerializationFailure is the kind of SerializationFailureError
+	ErrSerializationFailure = errors.New("serialization failure")
+	// ErrConversion is the kind of ConversionError
+	ErrConversion = errors.New("conversion error")
+)
+
+// The SQLSTATE codes of the errors ClassifyError classifies
+const (
+	uniqueViolation      = "23505"
+	foreignKeyViolation  = "23503"
+	checkViolation       = "23514"
+	serializationFailure = "40001"
+)
+
+// NotFoundError is returned when a row doesn't exist
+type NotFoundError struct {
+	Err error
+}
+
+func (e *NotFoundError) Error() string { return e.Err.Error() }
+
+func (e *NotFoundError) Unwrap() error { return e.Err }
+
+func (e *NotFoundError) Is(target error) bool { return target == ErrNotFound }
+
+func (e *NotFoundError) GRPCStatus() *status.Status { return status.New(codes.NotFound, e.Error()) }
+
+// UniqueViolationError is returned when a write violates a unique constraint. Fields are the columns of the constraint,
+// when the database reports them
+type UniqueViolationError struct {
+	Constraint string
+	Fields     []string
+	Err        error
+}
+
+func (e *UniqueViolationError) Error() string { return e.Err.Error() }
+
+func (e *UniqueViolationError) Unwrap() error { return e.Err }
+
+func (e *UniqueViolationError) Is(target error) bool { return target == ErrUniqueViolation }
+
+func (e *UniqueViolationError) GRPCStatus() *status.Status {
+	return status.New(codes.AlreadyExists, e.Error())
+}
+
+// ForeignKeyViolationError is returned when a write references a row that doesn't exist, or a delete leaves rows
+// referencing the deleted row
+type ForeignKeyViolationError struct {
+	Constraint string
+	Err        error
+}
+
+func (e *ForeignKeyViolationError) Error() string { return e.Err.Error() }
+
+func (e *ForeignKeyViolationError) Unwrap() error { return e.Err }
+
+func (e *ForeignKeyViolationError) Is(target error) bool { return target == ErrForeignKeyViolation }
+
+func (e *ForeignKeyViolationError) GRPCStatus() *status.Status {
+	return status.New(codes.FailedPrecondition, e.Error())
+}
+
+// CheckViolationError is returned when a write violates a CHECK constraint
+type CheckViolationError struct {
+	Constraint string
+	Err        error
+}
+
+func (e *CheckViolationError) Error() string { return e.Err.Error() }
+
+func (e *CheckViolationError) Unwrap() error { return e.Err }
+
+func (e *CheckViolationError) Is(target error) bool { return target == ErrCheckViolation }
+
+func (e *CheckViolationError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+// SerializationFailureError is returned when a transaction conflicts with a concurrent one, it may be retried
+type SerializationFailureError struct {
+	Err error
+}
+
+func (e *SerializationFailureError) Error() string { return e.Err.Error() }
+
+func (e *SerializationFailureError) Unwrap() error { return e.Err }
+
+func (e *SerializationFailureError) Is(target error) bool { return target == ErrSerializationFailure }
+
+func (e *SerializationFailureError) GRPCStatus() *status.Status {
+	return status.New(codes.Aborted, e.Error())
+}
+
+// ConversionError is returned when a proto can't be converted to its model, or a model to its proto
+type ConversionError struct {
+	Err error
+}
+
+func (e *ConversionError) Error() string { return e.Err.Error() }
+
+func (e *ConversionError) Unwrap() error { return e.Err }
+
+func (e *ConversionError) Is(target error) bool { return target == ErrConversion }
+
+func (e *ConversionError) GRPCStatus() *status.Status {
+	return status.New(codes.InvalidArgument, e.Error())
+}
+
+// keyFieldsPattern matches the columns of the key postgres and cockroachdb report in the detail of unique violations,
+// e.g. Key (warehouse, sku)=(north, 1234) already exists.
+var keyFieldsPattern = regexp.MustCompile(`^Key \((.+?)\)=`)
+
+// ClassifyError returns gorm's and the postgres drivers' errors as the typed error of their kind. Errors that aren't of
+// one of the kinds, and errors that already have a grpc status, are returned as they are
+func ClassifyError(err error) error {
+	var withStatus interface{ GRPCStatus() *status.Status }
+	if err == nil || errors.As(err, &withStatus) {
+		return err
+	}
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return &NotFoundError{Err: err}
+	case errors.Is(err, gorm.ErrDuplicatedKey):
+		return &UniqueViolationError{Err: err}
+	}
+	// gorm's postgres driver uses pgx, database/sql connections may use lib/pq
+	var code, constraint, detail string
+	var pgxErr *pgconn.PgError
+	var pqErr *pq.Error
+	switch {
+	case errors.As(err, &pgxErr):
+		code, constraint, detail = pgxErr.Code, pgxErr.ConstraintName, pgxErr.Detail
+	case errors.As(err, &pqErr):
+		code, constraint, detail = string(pqErr.Code), pqErr.Constraint, pqErr.Detail
+	default:
+		return err
+	}
+	switch code {
+	case uniqueViolation:
+		return &UniqueViolationError{Constraint: constraint, Fields: keyFields(detail), Err: err}
+	case foreignKeyViolation:
+		return &ForeignKeyViolationError{Constraint: constraint, Err: err}
+	case checkViolation:
+		return &CheckViolationError{Constraint: constraint, Err: err}
+	case serializationFailure:
+		return &SerializationFailureError{Err: err}
+	}
+	return err
+}
+
+// keyFields returns the columns of the key of a unique violation's detail
+func keyFields(detail string) []string {
+	match := keyFieldsPattern.FindStringSubmatch(detail)
+	if match == nil {
+		return nil
+	}
+	fields := strings.Split(match[1], ", ")
+	for i, field := range fields {
+		fields[i] = strings.Trim(field, `"`)
+	}
+	return fields
+}
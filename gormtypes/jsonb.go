@@ -0,0 +1,33 @@
+package gormtypes
+
+import (
+	"encoding/json"
+
+	gorm_jsonb "github.com/dariubs/gorm-jsonb"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// MessageToJSONB marshals a message with protojson into the value of a jsonb column. The value is written with sorted
+// keys and without protojson's randomized whitespace, so the stored json is stable
+func MessageToJSONB(message proto.Message, options protojson.MarshalOptions) (gorm_jsonb.JSONB, error) {
+	jsonBytes, err := options.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+	value := gorm_jsonb.JSONB{}
+	if err = json.Unmarshal(jsonBytes, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// JSONBToMessage unmarshals the value of a jsonb column into a message with protojson. Fields the message no longer has
+// are discarded, and both json and proto field names, and enum names and numbers, are accepted
+func JSONBToMessage(value gorm_jsonb.JSONB, message proto.Message) error {
+	jsonBytes, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(jsonBytes, message)
+}
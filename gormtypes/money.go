@@ -0,0 +1,37 @@
+// Package gormtypes holds the types and helpers used by generated code, to store google.type messages and to scope
+// queries to tenants
+package gormtypes
+
+import (
+	"fmt"
+	"math/big"
+)
+
+const nanosPerUnit = 1_000_000_000
+
+// FormatMoneyAmount formats the units and nanos of a google.type.Money as an exact decimal string suitable for a numeric
+// column, e.g. 12 and 500000000 become 12.500000000
+func FormatMoneyAmount(units int64, nanos int32) string {
+	total := new(big.Int).Mul(big.NewInt(units), big.NewInt(nanosPerUnit))
+	total.Add(total, big.NewInt(int64(nanos)))
+	return new(big.Rat).SetFrac(total, big.NewInt(nanosPerUnit)).FloatString(9)
+}
+
+// ParseMoneyAmount parses a decimal string read from a numeric column into the units and nanos of a google.type.Money.
+// Amounts with more than 9 decimal places or whose units overflow an int64 are rejected
+func ParseMoneyAmount(amount string) (units int64, nanos int32, err error) {
+	rat, ok := new(big.Rat).SetString(amount)
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid money amount %q", amount)
+	}
+	total := new(big.Rat).Mul(rat, new(big.Rat).SetInt64(nanosPerUnit))
+	if !total.IsInt() {
+		return 0, 0, fmt.Errorf("money amount %q has more than 9 decimal places", amount)
+	}
+	// Quo and Rem truncate toward zero, so units and nanos have the same sign as google.type.Money requires
+	quotient, remainder := new(big.Int).QuoRem(total.Num(), big.NewInt(nanosPerUnit), new(big.Int))
+	if !quotient.IsInt64() {
+		return 0, 0, fmt.Errorf("money amount %q overflows the units of a google.type.Money", amount)
+	}
+	return quotient.Int64(), int32(remainder.Int64()), nil
+}
@@ -0,0 +1,130 @@
+package gormtypes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxTable is the table the generated functions of messages with the outbox option insert their events into
+const OutboxTable = "outbox_events"
+
+// The operations of outbox events
+const (
+	OutboxCreate = "create"
+	OutboxUpdate = "update"
+	OutboxDelete = "delete"
+)
+
+// OutboxEvent is a change to a row of a message with the outbox option, inserted in the same transaction as the change.
+// Payload is the proto of the row after the change, or before it for deletes, in the binary wire format
+type OutboxEvent struct {
+	Id          int64      `gorm:"primaryKey;autoIncrement" json:"id"`
+	MessageName string     `json:"messageName"`
+	RecordId    string     `gorm:"type:uuid" json:"recordId"`
+	Operation   string     `json:"operation"`
+	Payload     []byte     `json:"payload"`
+	CreatedAt   *time.Time `json:"createdAt"`
+}
+
+func (e *OutboxEvent) TableName() string {
+	return OutboxTable
+}
+
+// Message unmarshals the payload into a message of the event's message name, which must be linked into the binary
+func (e *OutboxEvent) Message() (proto.Message, error) {
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(e.MessageName))
+	if err != nil {
+		return nil, err
+	}
+	message := messageType.New().Interface()
+	if err = proto.Unmarshal(e.Payload, message); err != nil {
+		return nil, err
+	}
+	return message, nil
+}
+
+// OutboxPublisher publishes outbox events, in the order they were inserted. Events are published at least once, a
+// publisher that fails after publishing some of them gets them again
+type OutboxPublisher func(ctx context.Context, events []*OutboxEvent) error
+
+// RelayOutbox claims up to limit of the oldest outbox events, hands them to the publisher, and deletes them once they're
+// published, all in one transaction. Events claimed by another relay's transaction are skipped rather than waited for,
+// so relays can run concurrently. It returns the number of published events, events stay in the outbox when the
+// publisher returns an error
+func RelayOutbox(ctx context.Context, db *gorm.DB, limit int, publish OutboxPublisher) (published int, err error) {
+	err = db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		events := []*OutboxEvent{}
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Order("id").
+			Limit(limit).
+			Find(&events).Error
+		if err != nil || len(events) == 0 {
+			return err
+		}
+		if err = publish(ctx, events); err != nil {
+			return err
+		}
+		ids := make([]int64, len(events))
+		for i, event := range events {
+			ids[i] = event.Id
+		}
+		if err = tx.Where("id in ?", ids).Delete(&OutboxEvent{}).Error; err != nil {
+			return err
+		}
+		published = len(events)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return published, nil
+}
+
+// outboxRelayMaxBackoff is the most times RunOutboxRelay doubles its wait after consecutive failures
+const outboxRelayMaxBackoff = 5
+
+// RunOutboxRelay relays outbox events with RelayOutbox until the context is done, waiting for the interval whenever the
+// outbox is empty. Failures are reported to onError, which may be nil, and retried after a wait that doubles with each
+// consecutive failure, up to 32 intervals. It returns the context's error once it's done, and an error right away when
+// the interval isn't positive
+func RunOutboxRelay(ctx context.Context, db *gorm.DB, limit int, interval time.Duration, publish OutboxPublisher,
+	onError func(error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("outbox relay interval must be positive, got %s", interval)
+	}
+	failures := 0
+	for {
+		published, err := RelayOutbox(ctx, db, limit, publish)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		wait := interval
+		switch {
+		case err != nil:
+			if onError != nil {
+				onError(err)
+			}
+			wait = interval << failures
+			if failures < outboxRelayMaxBackoff {
+				failures++
+			}
+		case published > 0:
+			failures = 0
+			continue
+		default:
+			failures = 0
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package gormtypes
+
+import (
+	"database/sql/driver"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+const (
+	wkbPointType = 1
+	ewkbSridFlag = 0x20000000
+	// srid is the spatial reference of points, WGS 84, the datum google.type.LatLng uses
+	srid = 4326
+)
+
+// Point is a PostGIS geography(Point,4326) column holding a google.type.LatLng
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Value writes the point as extended well known text, which PostGIS accepts for geography columns
+func (p Point) Value() (driver.Value, error) {
+	return fmt.Sprintf("SRID=%d;POINT(%s %s)", srid, strconv.FormatFloat(p.Longitude, 'f', -1, 64), strconv.FormatFloat(p.Latitude, 'f', -1, 64)), nil
+}
+
+// Scan reads the point from the extended well known binary PostGIS returns, either hex encoded or raw
+func (p *Point) Scan(src interface{}) (err error) {
+	var wkb []byte
+	switch value := src.(type) {
+	case string:
+		wkb, err = hex.DecodeString(value)
+	case []byte:
+		wkb = value
+		if len(value) > 0 && value[0] != 0 && value[0] != 1 {
+			wkb, err = hex.DecodeString(string(value))
+		}
+	default:
+		return fmt.Errorf("unsupported point source type %T", src)
+	}
+	if err != nil {
+		return fmt.Errorf("invalid point: %w", err)
+	}
+	return p.parseWKB(wkb)
+}
+
+func (p *Point) parseWKB(wkb []byte) error {
+	if len(wkb) < 21 {
+		return fmt.Errorf("invalid point: %d bytes is too short", len(wkb))
+	}
+	var byteOrder binary.ByteOrder = binary.BigEndian
+	if wkb[0] == 1 {
+		byteOrder = binary.LittleEndian
+	}
+	geometryType := byteOrder.Uint32(wkb[1:5])
+	offset := 5
+	if geometryType&ewkbSridFlag != 0 {
+		offset += 4
+	}
+	if geometryType&0xffff != wkbPointType {
+		return fmt.Errorf("invalid point: geometry type %d isn't a point", geometryType&0xffff)
+	}
+	if len(wkb) < offset+16 {
+		return fmt.Errorf("invalid point: %d bytes is too short", len(wkb))
+	}
+	p.Longitude = math.Float64frombits(byteOrder.Uint64(wkb[offset : offset+8]))
+	p.Latitude = math.Float64frombits(byteOrder.Uint64(wkb[offset+8 : offset+16]))
+	return nil
+}
@@ -0,0 +1,11 @@
+package gormtypes
+
+import "strings"
+
+// likeEscaper escapes the wildcards of LIKE patterns with backslashes, postgres and cockroachdb's default escape character
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// EscapeLike escapes the wildcards of text, so a LIKE pattern built from it matches the text as it is
+func EscapeLike(text string) string {
+	return likeEscaper.Replace(text)
+}
@@ -0,0 +1,37 @@
+package gormtypes
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// TenantSetting is the setting row level security policies read the current tenant from
+const TenantSetting = "app.tenant_id"
+
+var (
+	// ErrMissingTenant is returned by the generated functions of models with a tenant column when the context has no
+	// tenant
+	ErrMissingTenant = errors.New("missing tenant")
+	// ErrTenantMismatch is returned when writing rows of another tenant than the context's
+	ErrTenantMismatch = errors.New("row belongs to another tenant")
+)
+
+type tenantKey struct{}
+
+// WithTenant returns a copy of the context scoping the generated functions of models with a tenant column to the tenant
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant of the context, an empty tenant is no tenant
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok && tenant != ""
+}
+
+// SetTenantSetting sets the tenant row level security policies allow for the rest of the transaction
+func SetTenantSetting(tx *gorm.DB, tenant string) error {
+	return tx.Exec("SELECT set_config(?, ?, true)", TenantSetting, tenant).Error
+}
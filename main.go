@@ -48,6 +48,16 @@ func main() {
 
 		}
 
+		// the code shared by every file in a go package is generated once, into its own file
+		for _, goPackage := range plugin.GoPackages() {
+			gf := gp.NewGeneratedFile(goPackage.GeneratedFilename(), goPackage.GoImportPath)
+			err := plugin.ApplyPackageTemplate(gf, goPackage)
+			if err != nil {
+				gf.Skip()
+				gp.Error(err)
+			}
+		}
+
 		return nil
 	})
 }
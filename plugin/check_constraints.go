@@ -0,0 +1,361 @@
+package plugin
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	gorm "github.com/catalystcommunity/protoc-gen-go-gorm/options"
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+const (
+	// pgvRules is the field option of protoc-gen-validate, buf's original validate rules
+	pgvRules = "validate.rules"
+	// protovalidateRules is the field option of protovalidate, its rules share their names with protoc-gen-validate's
+	protovalidateRules = "buf.validate.field"
+)
+
+var numberRuleTypes = map[protoreflect.Name]bool{
+	"float": true, "double": true, "int32": true, "int64": true, "uint32": true, "uint64": true, "sint32": true,
+	"sint64": true, "fixed32": true, "fixed64": true, "sfixed32": true, "sfixed64": true,
+}
+
+// validationTypes holds the validation rule extensions imported by each file, by file path
+var validationTypes = map[string]*protoregistry.Types{}
+
+// CheckConstraint is a CHECK constraint derived from a field's validation rules
+type CheckConstraint struct {
+	Name       string
+	Expression string
+}
+
+// CheckConstraints returns the CHECK constraints of the model's fields, named like gorm names the constraints of check
+// tags so AutoMigrate and the DDL statements don't create them twice
+func (m *Model) CheckConstraints() (constraints []*CheckConstraint) {
+	for _, field := range m.Fields {
+		if field.Check != "" {
+			constraints = append(constraints, &CheckConstraint{
+				Name:       getCheckConstraintName(m.TableName, getColumnName(field.GoName)),
+				Expression: field.Check,
+			})
+		}
+	}
+	return
+}
+
+// DDL returns the statement adding the constraint to the table
+func (c *CheckConstraint) DDL(table string) string {
+	return fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s CHECK (%s)", table, c.Name, c.Expression)
+}
+
+// getCheckConstraintName returns gorm's default name for the check constraint of a column, see
+// schema.NamingStrategy.CheckerName
+func getCheckConstraintName(table, column string) string {
+	name := strings.ReplaceAll(strings.Join([]string{"chk", table, column}, "_"), ".", "_")
+	if utf8.RuneCountInString(name) > 64 {
+		hash := sha1.Sum([]byte(name))
+		name = name[0:56] + hex.EncodeToString(hash[:])[:8]
+	}
+	return name
+}
+
+// getCheckTag returns the gorm check tag of the field's check constraint. The leading comma gives the constraint
+// gorm's default name, semicolons are escaped for gorm and quotes and backslashes for the struct tag
+func getCheckTag(field *ModelField) string {
+	if field.Check == "" {
+		return ""
+	}
+	check := strings.ReplaceAll(field.Check, ";", `\;`)
+	check = strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(check)
+	return fmt.Sprintf("check:,%s;", check)
+}
+
+// getCheckConstraint returns the CHECK constraint expression enforcing the supported subset of the field's
+// protoc-gen-validate or protovalidate rules, or an empty string when it has none:
+//   - const, gt, gte, lt, lte, in and not_in of numbers
+//   - const, len, min_len, max_len, len_bytes, min_bytes, max_bytes, pattern, in and not_in of strings
+//   - len, min_len and max_len of bytes
+//   - defined_only, in and not_in of enums, defined_only is left to the type of native enums
+//   - min_items and max_items of repeated scalars and enums
+//
+// ignore_empty allows the field's zero value, and null always passes a CHECK constraint so optional fields can be
+// unset. Fields that aren't stored in a single column of their own, timestamps, lookup table enums and the fields of
+// views have none
+func getCheckConstraint(field *ModelField) string {
+	if getMessageOptions(field.Parent).GetView() != nil || field.Embedded || field.IsMessage || field.IsJsonb || field.IsCustomType || field.IsTimestamp || field.EnumAsLookup ||
+		field.Options.GetTimeFormatOverride() != "" {
+		return ""
+	}
+	rules := getValidationRules(field.Field)
+	if rules == nil {
+		return ""
+	}
+	typeOneof := rules.Descriptor().Oneofs().ByName("type")
+	if typeOneof == nil {
+		return ""
+	}
+	typeField := rules.WhichOneof(typeOneof)
+	if typeField == nil || typeField.Message() == nil {
+		return ""
+	}
+	typeRules := rules.Get(typeField).Message()
+	column := getColumnName(field.GoName)
+	var clauses []string
+	var zero string
+	switch {
+	case field.IsRepeated:
+		if typeField.Name() != "repeated" {
+			return ""
+		}
+		length := fmt.Sprintf("coalesce(array_length(%s, 1), 0)", column)
+		clauses = lengthClauses(length, typeRules, "", "min_items", "max_items")
+		zero = length + " = 0"
+	case field.Enum != nil:
+		if typeField.Name() != "enum" {
+			return ""
+		}
+		clauses = enumClauses(field, column, typeRules)
+		zero = fmt.Sprintf("%s = %s", column, enumLiteral(field, 0))
+	case field.Desc.Kind() == protoreflect.StringKind:
+		if typeField.Name() != "string" {
+			return ""
+		}
+		clauses = stringClauses(column, typeRules)
+		zero = column + " = ''"
+	case field.Desc.Kind() == protoreflect.BytesKind:
+		if typeField.Name() != "bytes" {
+			return ""
+		}
+		length := fmt.Sprintf("octet_length(%s)", column)
+		clauses = lengthClauses(length, typeRules, "len", "min_len", "max_len")
+		zero = length + " = 0"
+	case numberRuleTypes[typeField.Name()]:
+		clauses = numberClauses(column, typeRules)
+		zero = column + " = 0"
+	}
+	if len(clauses) == 0 {
+		return ""
+	}
+	check := strings.Join(clauses, " AND ")
+	if ignoreEmpty(rules, typeRules) {
+		check = fmt.Sprintf("%s OR (%s)", zero, check)
+	}
+	return check
+}
+
+// getValidationRules returns the field's validation rules. Neither validation library is linked into the plugin, so
+// the field options are parsed again with the rule extensions of the descriptors imported by the field's file
+func getValidationRules(field *protogen.Field) protoreflect.Message {
+	options, ok := field.Desc.Options().(*descriptorpb.FieldOptions)
+	if !ok || options == nil {
+		return nil
+	}
+	types := getValidationTypes(field.Desc.ParentFile())
+	if types.NumExtensions() == 0 {
+		return nil
+	}
+	bytes, err := proto.Marshal(options)
+	if err != nil {
+		return nil
+	}
+	parsed := &descriptorpb.FieldOptions{}
+	if err = (proto.UnmarshalOptions{Resolver: types}).Unmarshal(bytes, parsed); err != nil {
+		return nil
+	}
+	var rules protoreflect.Message
+	parsed.ProtoReflect().Range(func(descriptor protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if descriptor.IsExtension() && (descriptor.FullName() == pgvRules || descriptor.FullName() == protovalidateRules) {
+			rules = value.Message()
+			return false
+		}
+		return true
+	})
+	return rules
+}
+
+func getValidationTypes(file protoreflect.FileDescriptor) *protoregistry.Types {
+	if types, ok := validationTypes[file.Path()]; ok {
+		return types
+	}
+	types := &protoregistry.Types{}
+	registerValidationExtensions(types, file, map[string]bool{})
+	validationTypes[file.Path()] = types
+	return types
+}
+
+func registerValidationExtensions(types *protoregistry.Types, file protoreflect.FileDescriptor, seen map[string]bool) {
+	if seen[file.Path()] {
+		return
+	}
+	seen[file.Path()] = true
+	extensions := file.Extensions()
+	for i := 0; i < extensions.Len(); i++ {
+		if name := extensions.Get(i).FullName(); name == pgvRules || name == protovalidateRules {
+			_ = types.RegisterExtension(dynamicpb.NewExtensionType(extensions.Get(i)))
+		}
+	}
+	imports := file.Imports()
+	for i := 0; i < imports.Len(); i++ {
+		registerValidationExtensions(types, imports.Get(i).FileDescriptor, seen)
+	}
+}
+
+// getRule returns the value of the rule with the given name when it's set
+func getRule(rules protoreflect.Message, name protoreflect.Name) (protoreflect.Value, bool) {
+	field := rules.Descriptor().Fields().ByName(name)
+	if field == nil || !rules.Has(field) {
+		return protoreflect.Value{}, false
+	}
+	return rules.Get(field), true
+}
+
+// ignoreEmpty is true when the rules don't apply to the field's zero value, set on the type's rules by
+// protoc-gen-validate and on the field's rules by protovalidate
+func ignoreEmpty(rules, typeRules protoreflect.Message) bool {
+	for _, message := range []protoreflect.Message{rules, typeRules} {
+		if value, ok := getRule(message, "ignore_empty"); ok && value.Bool() {
+			return true
+		}
+	}
+	return false
+}
+
+func numberClauses(column string, rules protoreflect.Message) (clauses []string) {
+	if value, ok := getRule(rules, "const"); ok {
+		clauses = append(clauses, fmt.Sprintf("%s = %v", column, value.Interface()))
+	}
+	var lowerClause, upperClause string
+	var lower, upper float64
+	for _, bound := range []struct{ name, operator string }{{"gt", ">"}, {"gte", ">="}, {"lt", "<"}, {"lte", "<="}} {
+		value, ok := getRule(rules, protoreflect.Name(bound.name))
+		if !ok {
+			continue
+		}
+		clause := fmt.Sprintf("%s %s %v", column, bound.operator, value.Interface())
+		if strings.HasPrefix(bound.name, "g") {
+			lowerClause, lower = clause, numberValue(value)
+		} else {
+			upperClause, upper = clause, numberValue(value)
+		}
+	}
+	if lowerClause != "" && upperClause != "" && lower > upper {
+		// a lower bound above the upper bound is an exclusive range, the value must be outside of it
+		clauses = append(clauses, fmt.Sprintf("(%s OR %s)", lowerClause, upperClause))
+	} else {
+		clauses = append(clauses, lo.Compact([]string{lowerClause, upperClause})...)
+	}
+	return append(clauses, listClauses(column, rules, func(value protoreflect.Value) string {
+		return fmt.Sprint(value.Interface())
+	})...)
+}
+
+func numberValue(value protoreflect.Value) float64 {
+	switch number := value.Interface().(type) {
+	case int32:
+		return float64(number)
+	case int64:
+		return float64(number)
+	case uint32:
+		return float64(number)
+	case uint64:
+		return float64(number)
+	case float32:
+		return float64(number)
+	case float64:
+		return number
+	}
+	return 0
+}
+
+func stringClauses(column string, rules protoreflect.Message) (clauses []string) {
+	if value, ok := getRule(rules, "const"); ok {
+		clauses = append(clauses, fmt.Sprintf("%s = %s", column, sqlString(value.String())))
+	}
+	clauses = append(clauses, lengthClauses(fmt.Sprintf("char_length(%s)", column), rules, "len", "min_len", "max_len")...)
+	clauses = append(clauses, lengthClauses(fmt.Sprintf("octet_length(%s)", column), rules, "len_bytes", "min_bytes", "max_bytes")...)
+	// a backtick can't be escaped in the struct tag, so such patterns are left to the api
+	if value, ok := getRule(rules, "pattern"); ok && !strings.Contains(value.String(), "`") {
+		clauses = append(clauses, fmt.Sprintf("%s ~ %s", column, sqlString(value.String())))
+	}
+	return append(clauses, listClauses(column, rules, func(value protoreflect.Value) string {
+		return sqlString(value.String())
+	})...)
+}
+
+// lengthClauses returns the clauses of the exact, min and max length rules with the given names, an empty name is
+// skipped
+func lengthClauses(length string, rules protoreflect.Message, exactRule, minRule, maxRule protoreflect.Name) (clauses []string) {
+	for _, bound := range []struct {
+		name     protoreflect.Name
+		operator string
+	}{{exactRule, "="}, {minRule, ">="}, {maxRule, "<="}} {
+		if bound.name == "" {
+			continue
+		}
+		if value, ok := getRule(rules, bound.name); ok {
+			clauses = append(clauses, fmt.Sprintf("%s %s %d", length, bound.operator, value.Uint()))
+		}
+	}
+	return
+}
+
+func enumClauses(field *ModelField, column string, rules protoreflect.Message) (clauses []string) {
+	if value, ok := getRule(rules, "defined_only"); ok && value.Bool() && field.EnumStrategy != gorm.EnumStrategy_NATIVE {
+		values := []string{}
+		for _, enumValue := range field.Enum.Values {
+			values = append(values, enumLiteral(field, enumValue.Desc.Number()))
+		}
+		clauses = append(clauses, fmt.Sprintf("%s IN (%s)", column, strings.Join(lo.Uniq(values), ", ")))
+	}
+	if value, ok := getRule(rules, "const"); ok {
+		clauses = append(clauses, fmt.Sprintf("%s = %s", column, enumLiteral(field, protoreflect.EnumNumber(value.Int()))))
+	}
+	return append(clauses, listClauses(column, rules, func(value protoreflect.Value) string {
+		return enumLiteral(field, protoreflect.EnumNumber(value.Int()))
+	})...)
+}
+
+// enumLiteral returns the stored value of the enum number, which is the number itself for int enums
+func enumLiteral(field *ModelField, number protoreflect.EnumNumber) string {
+	if !field.EnumAsString {
+		return fmt.Sprint(number)
+	}
+	for _, value := range field.Enum.Values {
+		if value.Desc.Number() == number {
+			return sqlString(getEnumValueStoredValue(value))
+		}
+	}
+	return sqlString(fmt.Sprint(number))
+}
+
+// listClauses returns the clauses of the in and not_in rules
+func listClauses(column string, rules protoreflect.Message, literal func(protoreflect.Value) string) (clauses []string) {
+	for _, rule := range []struct {
+		name     protoreflect.Name
+		operator string
+	}{{"in", "IN"}, {"not_in", "NOT IN"}} {
+		value, ok := getRule(rules, rule.name)
+		if !ok || !value.List().IsValid() || value.List().Len() == 0 {
+			continue
+		}
+		values := []string{}
+		for i := 0; i < value.List().Len(); i++ {
+			values = append(values, literal(value.List().Get(i)))
+		}
+		clauses = append(clauses, fmt.Sprintf("%s %s (%s)", column, rule.operator, strings.Join(values, ", ")))
+	}
+	return
+}
+
+func sqlString(value string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "''"))
+}
@@ -0,0 +1,43 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+func (f *ModelField) parseCustomType() (err error) {
+	options := f.Options.CustomType
+	if options.GoImportPath == "" || options.GoType == "" || options.ToModel == "" || options.ToProto == "" {
+		return fmt.Errorf("field %s: custom_type requires go_import_path, go_type, to_model and to_proto", f.Desc.FullName())
+	}
+	if f.IsRepeated {
+		return fmt.Errorf("field %s: custom_type is not supported on repeated fields", f.Desc.FullName())
+	}
+	f.IsCustomType = true
+	f.CustomToModel = g.QualifiedGoIdent(customTypeFuncIdent(options.GoImportPath, options.ToModel))
+	f.CustomToProto = g.QualifiedGoIdent(customTypeFuncIdent(options.GoImportPath, options.ToProto))
+	return
+}
+
+func getCustomModelFieldType(field *ModelField) string {
+	options := field.Options.CustomType
+	pointer := ""
+	goType := options.GoType
+	if strings.HasPrefix(goType, "*") {
+		pointer = "*"
+		goType = strings.TrimPrefix(goType, "*")
+	}
+	return pointer + g.QualifiedGoIdent(protogen.GoIdent{GoName: goType, GoImportPath: protogen.GoImportPath(options.GoImportPath)})
+}
+
+// customTypeFuncIdent resolves a converter function name. Fully qualified names, e.g.
+// github.com/acme/converters.DecimalFromString, are split into their import path and name, anything else is a function
+// in the custom type's package
+func customTypeFuncIdent(goImportPath, name string) protogen.GoIdent {
+	if index := strings.LastIndex(name, "."); index > strings.LastIndex(name, "/") {
+		return protogen.GoIdent{GoName: name[index+1:], GoImportPath: protogen.GoImportPath(name[:index])}
+	}
+	return protogen.GoIdent{GoName: name, GoImportPath: protogen.GoImportPath(goImportPath)}
+}
@@ -0,0 +1,76 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/stoewer/go-strcase"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// EmbeddedModel is the struct generated for a message that's embedded into the tables of the messages referencing it
+type EmbeddedModel struct {
+	*protogen.Message
+	Name   string
+	Fields []*ModelField
+}
+
+func (m *EmbeddedModel) Parse() (err error) {
+	m.Name = getEmbeddedModelName(m.Message)
+	m.Fields = []*ModelField{}
+	for _, field := range m.Message.Fields {
+		modelField := &ModelField{Field: field, Embedded: true}
+		if err = modelField.Parse(); err != nil {
+			return
+		}
+		if modelField.Ignore {
+			continue
+		}
+		if modelField.IsMessage && !(modelField.IsTimestamp || modelField.IsStructPb || modelField.IsJsonb || modelField.IsEmbedded || modelField.GoogleType != "") {
+			return fmt.Errorf("field %s: embedded messages only support timestamp, jsonb, google.type and embedded message fields", field.Desc.FullName())
+		}
+		m.Fields = append(m.Fields, modelField)
+	}
+	return
+}
+
+func getEmbeddedModelName(message *protogen.Message) string {
+	return fmt.Sprintf("%sGormEmbedded", message.GoIdent.GoName)
+}
+
+func getEmbeddedPrefix(field *ModelField) string {
+	if field.Options.EmbeddedPrefix != "" {
+		return field.Options.EmbeddedPrefix
+	}
+	return fmt.Sprintf("%s_", strcase.SnakeCase(field.GoName))
+}
+
+// prepareEmbeddedModels collects the embedded models used by the given messages' fields, including embedded models
+// nested in other embedded models
+func prepareEmbeddedModels(messages []*PreparedMessage) (embeddedModels []*EmbeddedModel, err error) {
+	seen := map[protoreflect.FullName]bool{}
+	var collect func(fields []*ModelField) error
+	collect = func(fields []*ModelField) error {
+		for _, field := range fields {
+			if !field.IsEmbedded || seen[field.Message.Desc.FullName()] {
+				continue
+			}
+			seen[field.Message.Desc.FullName()] = true
+			embeddedModel := &EmbeddedModel{Message: field.Message}
+			if err := embeddedModel.Parse(); err != nil {
+				return err
+			}
+			embeddedModels = append(embeddedModels, embeddedModel)
+			if err := collect(embeddedModel.Fields); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, message := range messages {
+		if err = collect(message.Model.Fields); err != nil {
+			return
+		}
+	}
+	return
+}
@@ -0,0 +1,92 @@
+package plugin
+
+import "text/template"
+
+var enumTemplate = template.Must(template.New("enums").Parse(`
+{{ range .enums }}
+{{ if .NeedsStoredValues }}
+// {{ .GoIdent.GoName }}StoredValues maps {{ .GoIdent.GoName }} values to the values stored in the database
+var {{ .GoIdent.GoName }}StoredValues = map[{{ .GoType }}]string{
+	{{- range .Values }}
+	{{ .GoIdent }}: {{ printf "%q" .StoredValue }},
+	{{- end }}
+}
+
+// {{ .GoIdent.GoName }}FromStoredValues maps values stored in the database to {{ .GoIdent.GoName }} values
+var {{ .GoIdent.GoName }}FromStoredValues = map[string]{{ .GoType }}{
+	{{- range .Values }}
+	{{ printf "%q" .StoredValue }}: {{ .GoIdent }},
+	{{- end }}
+}
+
+// {{ .GoIdent.GoName }}ToStoredValue returns the value stored in the database for the given enum value, unknown values
+// are stored as their number
+func {{ .GoIdent.GoName }}ToStoredValue(value {{ .GoType }}) string {
+	if stored, ok := {{ .GoIdent.GoName }}StoredValues[value]; ok {
+		return stored
+	}
+	return value.String()
+}
+
+// {{ .GoIdent.GoName }}FromStoredValue returns the enum value for the given stored value. Unknown stored values are
+// mapped to 0, or return an error when strict is true
+func {{ .GoIdent.GoName }}FromStoredValue(stored string, strict bool) ({{ .GoType }}, error) {
+	if value, ok := {{ .GoIdent.GoName }}FromStoredValues[stored]; ok {
+		return value, nil
+	}
+	if strict {
+		return 0, fmt.Errorf("unknown stored value %q for enum {{ .Desc.FullName }}", stored)
+	}
+	return 0, nil
+}
+{{ end }}
+{{ if .NeedsNativeType }}
+// {{ .GoIdent.GoName }}TypeDDL creates the native {{ .TypeName }} enum type
+const {{ .GoIdent.GoName }}TypeDDL = {{ printf "%q" .NativeTypeDDL }}
+{{ end }}
+{{ if .NeedsLookupTable }}
+// {{ .LookupModelName }} is a row of the {{ .LookupTable }} lookup table, which fields using the lookup_table
+// strategy reference with a foreign key
+type {{ .LookupModelName }} struct {
+	Id   int    ` + "`" + `gorm:"primaryKey;autoIncrement:false" json:"id"` + "`" + `
+	Name string ` + "`" + `gorm:"type:text;not null;uniqueIndex" json:"name"` + "`" + `
+}
+
+func (m *{{ .LookupModelName }}) TableName() string {
+	return "{{ .LookupTable }}"
+}
+
+// {{ .LookupModelName }}s returns a lookup table row for every {{ .GoIdent.GoName }} value
+func {{ .LookupModelName }}s() []*{{ .LookupModelName }} {
+	return []*{{ .LookupModelName }}{
+		{{- range .Values }}
+		{Id: {{ .Number }}, Name: {{ printf "%q" .StoredValue }}},
+		{{- end }}
+	}
+}
+{{ end }}
+{{ end }}
+{{ if .migrate }}
+// MigrateEnums creates the native enum types and the enum lookup tables used by the models in this package, and seeds
+// the lookup tables. It must be called before auto migrating those models
+func MigrateEnums(ctx context.Context, db *gorm.DB) error {
+	session := db.Session(&gorm.Session{}).WithContext(ctx)
+	{{- range .enums }}
+	{{- if .NeedsNativeType }}
+	if err := session.Exec({{ .GoIdent.GoName }}TypeDDL).Error; err != nil {
+		return err
+	}
+	{{- end }}
+	{{- if .NeedsLookupTable }}
+	if err := session.AutoMigrate(&{{ .LookupModelName }}{}); err != nil {
+		return err
+	}
+	if err := session.Clauses(clause.OnConflict{UpdateAll: true}).Create({{ .LookupModelName }}s()).Error; err != nil {
+		return err
+	}
+	{{- end }}
+	{{- end }}
+	return nil
+}
+{{ end }}
+`))
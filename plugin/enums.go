@@ -0,0 +1,219 @@
+package plugin
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+	"strings"
+
+	gorm "github.com/catalystcommunity/protoc-gen-go-gorm/options"
+	"github.com/stoewer/go-strcase"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// PreparedEnum holds everything the enum template needs to render the stored value maps, native type ddl and lookup
+// table model for an enum used by one or more ormable fields
+type PreparedEnum struct {
+	*protogen.Enum
+	GoType          string
+	TypeName        string
+	LookupTable     string
+	LookupModelName string
+	Values          []*PreparedEnumValue
+	// NeedsStoredValues is true when a string or native field uses the enum
+	NeedsStoredValues bool
+	// NeedsNativeType is true when a native field uses the enum
+	NeedsNativeType bool
+	// NeedsLookupTable is true when a lookup table field uses the enum
+	NeedsLookupTable bool
+}
+
+type PreparedEnumValue struct {
+	GoIdent     string
+	StoredValue string
+	Number      protoreflect.EnumNumber
+}
+
+var enumStrategyParamMap = map[string]gorm.EnumStrategy{
+	"int":          gorm.EnumStrategy_INT,
+	"string":       gorm.EnumStrategy_STRING,
+	"native":       gorm.EnumStrategy_NATIVE,
+	"lookup_table": gorm.EnumStrategy_LOOKUP_TABLE,
+}
+
+// getEnumStrategy resolves the storage strategy of an enum field. Field options take precedence over file options, which
+// take precedence over the enum_strategy plugin parameter, which takes precedence over the deprecated enums_as_ints
+func getEnumStrategy(field *ModelField) (strategy gorm.EnumStrategy, err error) {
+	if field.Options.EnumStrategy != gorm.EnumStrategy_ENUM_STRATEGY_UNDEFINED {
+		return field.Options.EnumStrategy, nil
+	}
+	if field.Options.EnumAsString {
+		return gorm.EnumStrategy_STRING, nil
+	}
+	fileOptions := getFileOptions(field.Parent.Desc.ParentFile())
+	if fileOptions != nil && fileOptions.EnumStrategy != gorm.EnumStrategy_ENUM_STRATEGY_UNDEFINED {
+		return fileOptions.EnumStrategy, nil
+	}
+	if *enumsAsInts && !isFlagSet("enum_strategy") {
+		return gorm.EnumStrategy_INT, nil
+	}
+	strategy, ok := enumStrategyParamMap[*enumStrategy]
+	if !ok {
+		return strategy, fmt.Errorf("unsupported enum_strategy %s, supported strategies are 'int', 'string', 'native' and 'lookup_table'", *enumStrategy)
+	}
+	return
+}
+
+// isFlagSet is true when the plugin parameter with the given name is given, rather than left to its default
+func isFlagSet(name string) (set bool) {
+	flag.Visit(func(f *flag.Flag) {
+		set = set || f.Name == name
+	})
+	return
+}
+
+func isStrictEnum(field *ModelField) bool {
+	if field.Options.StrictEnum {
+		return true
+	}
+	fileOptions := getFileOptions(field.Parent.Desc.ParentFile())
+	return fileOptions != nil && fileOptions.StrictEnums
+}
+
+func getEnumOptions(enum *protogen.Enum) *gorm.GormEnumOptions {
+	options, ok := enum.Desc.Options().(*descriptorpb.EnumOptions)
+	if !ok || options == nil {
+		return &gorm.GormEnumOptions{}
+	}
+	opts, ok := proto.GetExtension(options, gorm.E_EnumOpts).(*gorm.GormEnumOptions)
+	if !ok || opts == nil {
+		return &gorm.GormEnumOptions{}
+	}
+	return opts
+}
+
+func getEnumValueStoredValue(value *protogen.EnumValue) string {
+	if options, ok := value.Desc.Options().(*descriptorpb.EnumValueOptions); ok && options != nil {
+		if opts, ok := proto.GetExtension(options, gorm.E_EnumValue).(*gorm.GormEnumValueOptions); ok && opts != nil && opts.StoredValue != "" {
+			return opts.StoredValue
+		}
+	}
+	return string(value.Desc.Name())
+}
+
+func getEnumTypeName(enum *protogen.Enum) string {
+	if options := getEnumOptions(enum); options.TypeName != "" {
+		return options.TypeName
+	}
+	return strcase.SnakeCase(enum.GoIdent.GoName)
+}
+
+func getEnumLookupTable(enum *protogen.Enum) string {
+	if options := getEnumOptions(enum); options.LookupTable != "" {
+		return options.LookupTable
+	}
+	return pluralizer.Plural(strcase.SnakeCase(enum.GoIdent.GoName))
+}
+
+func getEnumLookupModelName(enum *protogen.Enum) string {
+	return fmt.Sprintf("%sLookupGormModel", enum.GoIdent.GoName)
+}
+
+// prepareEnums collects the enums used by the given messages' and embedded models' fields that need generated helpers,
+// in a stable order
+func prepareEnums(messages []*PreparedMessage, embeddedModels []*EmbeddedModel) []*PreparedEnum {
+	enums := map[protoreflect.FullName]*PreparedEnum{}
+	fieldSets := [][]*ModelField{}
+	for _, message := range messages {
+		fieldSets = append(fieldSets, message.Model.Fields)
+	}
+	for _, embeddedModel := range embeddedModels {
+		fieldSets = append(fieldSets, embeddedModel.Fields)
+	}
+	for _, fields := range fieldSets {
+		for _, field := range fields {
+			if field.Enum == nil || field.EnumStrategy == gorm.EnumStrategy_INT {
+				continue
+			}
+			enum, ok := enums[field.Enum.Desc.FullName()]
+			if !ok {
+				enum = prepareEnum(field.Enum)
+				enums[field.Enum.Desc.FullName()] = enum
+			}
+			switch field.EnumStrategy {
+			case gorm.EnumStrategy_STRING:
+				g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "fmt"})
+				enum.NeedsStoredValues = true
+			case gorm.EnumStrategy_NATIVE:
+				g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "fmt"})
+				enum.NeedsStoredValues = true
+				enum.NeedsNativeType = true
+			case gorm.EnumStrategy_LOOKUP_TABLE:
+				enum.NeedsLookupTable = true
+			}
+		}
+	}
+	prepared := []*PreparedEnum{}
+	for _, enum := range enums {
+		prepared = append(prepared, enum)
+	}
+	sort.Slice(prepared, func(i, j int) bool {
+		return prepared[i].Desc.FullName() < prepared[j].Desc.FullName()
+	})
+	return prepared
+}
+
+func prepareEnum(enum *protogen.Enum) *PreparedEnum {
+	prepared := &PreparedEnum{
+		Enum:            enum,
+		GoType:          g.QualifiedGoIdent(enum.GoIdent),
+		TypeName:        getEnumTypeName(enum),
+		LookupTable:     getEnumLookupTable(enum),
+		LookupModelName: getEnumLookupModelName(enum),
+	}
+	for _, value := range enum.Values {
+		prepared.Values = append(prepared.Values, &PreparedEnumValue{
+			GoIdent:     g.QualifiedGoIdent(value.GoIdent),
+			StoredValue: getEnumValueStoredValue(value),
+			Number:      value.Desc.Number(),
+		})
+	}
+	return prepared
+}
+
+// NativeTypeDDL returns the statement creating the native enum type for the current engine. Postgres has no
+// CREATE TYPE IF NOT EXISTS, so the duplicate error is swallowed instead
+func (e *PreparedEnum) NativeTypeDDL() string {
+	values := []string{}
+	for _, value := range e.Values {
+		values = append(values, fmt.Sprintf("'%s'", strings.ReplaceAll(value.StoredValue, "'", "''")))
+	}
+	if *engine == cockroachdbEngine {
+		return fmt.Sprintf("CREATE TYPE IF NOT EXISTS %s AS ENUM (%s)", e.TypeName, strings.Join(values, ", "))
+	}
+	return fmt.Sprintf("DO $$ BEGIN CREATE TYPE %s AS ENUM (%s); EXCEPTION WHEN duplicate_object THEN null; END $$", e.TypeName, strings.Join(values, ", "))
+}
+
+func enumsNeedMigration(enums []*PreparedEnum) bool {
+	for _, enum := range enums {
+		if enum.NeedsNativeType || enum.NeedsLookupTable {
+			return true
+		}
+	}
+	return false
+}
+
+func getFileOptions(file protoreflect.FileDescriptor) *gorm.GormFileOptions {
+	options, ok := file.Options().(*descriptorpb.FileOptions)
+	if !ok || options == nil {
+		return nil
+	}
+	opts, ok := proto.GetExtension(options, gorm.E_FileOpts).(*gorm.GormFileOptions)
+	if !ok {
+		return nil
+	}
+	return opts
+}
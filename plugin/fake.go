@@ -0,0 +1,137 @@
+package plugin
+
+import (
+	"flag"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+var fakes = flag.Bool("fakes", false, "generate in memory fake repositories for tests")
+
+const (
+	fakeBelongsTo  = "belongs_to"
+	fakeHasOne     = "has_one"
+	fakeHasMany    = "has_many"
+	fakeManyToMany = "many_to_many"
+)
+
+// FakeAssociation describes how a fake repository loads an association from the fake store. Associations are loaded by
+// id, custom association foreign keys aren't supported
+type FakeAssociation struct {
+	*ModelField
+	Kind      string
+	Table     string
+	ModelName string
+	// ForeignKey is the go field holding the foreign key, on the parent's model for belongs to associations and on the
+	// associated model for has one and has many associations
+	ForeignKey        string
+	ForeignKeyPointer bool
+	// JoinTable is the key of a many to many association's join table in the fake store
+	JoinTable string
+}
+
+// IsAssociation is true for message fields stored as models of their own rather than in the parent's table
+func (f *ModelField) IsAssociation() bool {
+	return f.IsMessage && !f.IsTimestamp && !f.IsStructPb && !f.IsJsonb && !f.IsEmbedded && !f.IsCustomType && f.GoogleType == ""
+}
+
+// ForeignKeyOf returns the expression of the association's foreign key on the given model variable
+func (a *FakeAssociation) ForeignKeyOf(variable string) string {
+	if a.ForeignKeyPointer {
+		return fmt.Sprintf("lo.FromPtr(%s.%s)", variable, a.ForeignKey)
+	}
+	return fmt.Sprintf("%s.%s", variable, a.ForeignKey)
+}
+
+// FakeAssociations returns the associations a fake repository loads, which are the ones to messages in the same go
+// package, because the fake store only holds the package's models
+func (m *Model) FakeAssociations() (associations []*FakeAssociation) {
+	for _, field := range m.Fields {
+		if !field.IsAssociation() || field.Message.GoIdent.GoImportPath != m.Message.GoIdent.GoImportPath {
+			continue
+		}
+		association := &FakeAssociation{
+			ModelField: field,
+			Table:      getTableNameFromMessage(field.Message),
+			ModelName:  getModelNameFromMessage(field.Message),
+		}
+		options := field.Options
+		switch {
+		case options.GetBelongsTo() != nil:
+			association.Kind = fakeBelongsTo
+			association.ForeignKey = options.GetBelongsTo().Foreignkey
+			if association.ForeignKey == "" {
+				association.ForeignKey = fmt.Sprintf("%sId", field.GoName)
+			}
+			association.ForeignKeyPointer = foreignKeyIsPointer(m.Message, association.ForeignKey)
+		case options.GetHasOne() != nil || options.GetHasMany() != nil:
+			association.Kind = fakeHasOne
+			association.ForeignKey = options.GetHasOne().GetForeignkey()
+			if options.GetHasMany() != nil {
+				association.Kind = fakeHasMany
+				association.ForeignKey = options.GetHasMany().GetForeignkey()
+			}
+			if association.ForeignKey == "" {
+				association.ForeignKey = fmt.Sprintf("%sId", m.Message.GoIdent.GoName)
+			}
+			association.ForeignKeyPointer = foreignKeyIsPointer(field.Message, association.ForeignKey)
+		case options.GetManyToMany() != nil:
+			association.Kind = fakeManyToMany
+			association.JoinTable = getFakeJoinTable(m.Message, field)
+		default:
+			continue
+		}
+		associations = append(associations, association)
+	}
+	return
+}
+
+// HasTimestamp is true when the model has the given gorm tracked timestamp field, CreatedAt or UpdatedAt
+func (m *Model) HasTimestamp(goName string) bool {
+	for _, field := range m.Fields {
+		if field.GoName == goName && field.ModelType == "*time.Time" {
+			return true
+		}
+	}
+	return false
+}
+
+// foreignKeyIsPointer is true when the foreign key field is an optional field of the message, or is generated on the
+// model by a belongs to association because the message doesn't declare it
+func foreignKeyIsPointer(message *protogen.Message, goName string) bool {
+	for _, field := range message.Fields {
+		if field.GoName == goName {
+			return isOptional(field)
+		}
+	}
+	return true
+}
+
+func getFakeJoinTable(message *protogen.Message, field *ModelField) string {
+	return fmt.Sprintf("%s.%s", message.GoIdent.GoName, field.GoName)
+}
+
+// FakeJoinTable is a many to many association's join table in the fake store, which holds the ids of the associated
+// rows of AssociatedTable by the ids of the rows of Table
+type FakeJoinTable struct {
+	Name            string
+	Table           string
+	AssociatedTable string
+}
+
+// fakeJoinTables returns the join tables of the many to many fields of the given messages, in a stable order
+func fakeJoinTables(messages []*PreparedMessage) []*FakeJoinTable {
+	joinTables := []*FakeJoinTable{}
+	for _, message := range messages {
+		for _, association := range message.Model.FakeAssociations() {
+			if association.Kind == fakeManyToMany {
+				joinTables = append(joinTables, &FakeJoinTable{Name: association.JoinTable, Table: message.Model.TableName,
+					AssociatedTable: association.Table})
+			}
+		}
+	}
+	sort.Slice(joinTables, func(i, j int) bool { return joinTables[i].Name < joinTables[j].Name })
+	return joinTables
+}
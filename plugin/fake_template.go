@@ -0,0 +1,478 @@
+package plugin
+
+import "text/template"
+
+// fakeStoreTemplate renders the in memory store shared by the fake repositories of a go package
+var fakeStoreTemplate = template.Must(template.New("fake_store").Parse(`
+// FakeStore is an in memory stand in for the database, shared by the fake repositories of the package so associations
+// between them can be loaded. It holds models by table and id, and many to many associations by join table
+type FakeStore struct {
+	mutex      sync.RWMutex
+	tables     map[string]map[string]interface{}
+	joinTables map[string]map[string]map[string]bool
+	// joinTableSides are the tables of the rows on either side of each join table
+	joinTableSides map[string][2]string
+}
+
+func NewFakeStore() *FakeStore {
+	return &FakeStore{
+		tables: map[string]map[string]interface{}{
+			{{- range .messages }}
+			"{{ .Model.TableName }}": {},
+			{{- end }}
+		},
+		joinTables: map[string]map[string]map[string]bool{
+			{{- range .joinTables }}
+			"{{ .Name }}": {},
+			{{- end }}
+		},
+		joinTableSides: map[string][2]string{
+			{{- range .joinTables }}
+			"{{ .Name }}": {"{{ .Table }}", "{{ .AssociatedTable }}"},
+			{{- end }}
+		},
+	}
+}
+
+// deleteAssociations removes the many to many associations of a deleted row of the table from the join tables, on
+// either side of them
+func (s *FakeStore) deleteAssociations(table, id string) {
+	for joinTable, sides := range s.joinTableSides {
+		if sides[0] == table {
+			delete(s.joinTables[joinTable], id)
+		}
+		if sides[1] == table {
+			for _, associatedIds := range s.joinTables[joinTable] {
+				delete(associatedIds, id)
+			}
+		}
+	}
+}
+
+// fakeOrder sorts rows like an sql order by clause of comma separated columns with an optional asc or desc, then by
+// id. Like postgres, nulls sort after other values in ascending order
+func fakeOrder[T any](rows []T, order interface{}, columns map[string]func(T) interface{}) error {
+	orderBy := "id"
+	if order != nil {
+		clause, ok := order.(string)
+		if !ok {
+			return fmt.Errorf("fake repositories only support string orders, got %T", order)
+		}
+		if strings.TrimSpace(clause) != "" {
+			orderBy = clause + ", id"
+		}
+	}
+	type sortColumn struct {
+		value func(T) interface{}
+		desc  bool
+	}
+	sortColumns := []sortColumn{}
+	for _, column := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(strings.ToLower(column))
+		if len(parts) == 0 || len(parts) > 2 || (len(parts) == 2 && parts[1] != "asc" && parts[1] != "desc") {
+			return fmt.Errorf("unsupported order %q", column)
+		}
+		value, ok := columns[strings.Trim(parts[0], "\"")]
+		if !ok {
+			return fmt.Errorf("unknown order column %q", parts[0])
+		}
+		sortColumns = append(sortColumns, sortColumn{value: value, desc: len(parts) == 2 && parts[1] == "desc"})
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		for _, column := range sortColumns {
+			if compared := fakeCompare(column.value(rows[i]), column.value(rows[j])); compared != 0 {
+				return (compared < 0) != column.desc
+			}
+		}
+		return false
+	})
+	return nil
+}
+
+// fakeCompare compares two values of a column, nulls are greater than any other value
+func fakeCompare(a, b interface{}) int {
+	valueA, valueB := fakeIndirect(a), fakeIndirect(b)
+	switch {
+	case !valueA.IsValid() && !valueB.IsValid():
+		return 0
+	case !valueA.IsValid():
+		return 1
+	case !valueB.IsValid():
+		return -1
+	}
+	if timeA, ok := valueA.Interface().(time.Time); ok {
+		return timeA.Compare(valueB.Interface().(time.Time))
+	}
+	switch valueA.Kind() {
+	case reflect.String:
+		return strings.Compare(valueA.String(), valueB.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fakeCompareOrdered(valueA.Int(), valueB.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fakeCompareOrdered(valueA.Uint(), valueB.Uint())
+	case reflect.Float32, reflect.Float64:
+		return fakeCompareOrdered(valueA.Float(), valueB.Float())
+	case reflect.Bool:
+		return fakeCompareOrdered(lo.Ternary(valueA.Bool(), 1, 0), lo.Ternary(valueB.Bool(), 1, 0))
+	}
+	return strings.Compare(fmt.Sprint(valueA.Interface()), fmt.Sprint(valueB.Interface()))
+}
+
+func fakeCompareOrdered[T int | int64 | uint64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// fakeIndirect dereferences pointers, a nil value returns the zero reflect.Value
+func fakeIndirect(value interface{}) reflect.Value {
+	reflected := reflect.ValueOf(value)
+	for reflected.Kind() == reflect.Pointer {
+		if reflected.IsNil() {
+			return reflect.Value{}
+		}
+		reflected = reflected.Elem()
+	}
+	return reflected
+}
+
+// fakeConflicts is true when two rows have the same values of the conflict columns, like unique indexes rows with null
+// values don't conflict
+func fakeConflicts(a, b []interface{}) bool {
+	for i := range a {
+		if !fakeIndirect(a[i]).IsValid() || !fakeIndirect(b[i]).IsValid() || fakeCompare(a[i], b[i]) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// fakePage applies a limit and an offset like sql, a negative limit doesn't limit the rows
+func fakePage[T any](rows []T, limit, offset int) []T {
+	if offset > 0 {
+		if offset >= len(rows) {
+			return rows[:0]
+		}
+		rows = rows[offset:]
+	}
+	if limit >= 0 && limit < len(rows) {
+		rows = rows[:limit]
+	}
+	return rows
+}
+
+// fakeSortedKeys returns the keys of the map in order, so fakes return associations in a stable order
+func fakeSortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+`))
+
+// fakeTemplate renders a message's fake repository
+var fakeTemplate = template.Must(template.New("fake").Funcs(templateFuncs).Parse(`
+{{- $message := . }}
+// {{ .GoIdent.GoName }}FakeRepository is an in memory {{ .GoIdent.GoName }}Repository for tests.
+{{- if .Model.View }} Like the gorm repository, it only reads
+// the view, loading the associations to messages of the same go package one level deep
+{{- else }} Like the gorm repository, upserts
+// set missing ids and timestamps without saving associations, and reads load the associations to messages of the same
+// go package one level deep
+{{- end }}
+type {{ .GoIdent.GoName }}FakeRepository struct {
+	store *FakeStore
+}
+
+var _ {{ .GoIdent.GoName }}Repository = &{{ .GoIdent.GoName }}FakeRepository{}
+
+func New{{ .GoIdent.GoName }}FakeRepository(store *FakeStore) *{{ .GoIdent.GoName }}FakeRepository {
+	return &{{ .GoIdent.GoName }}FakeRepository{store: store}
+}
+
+// {{ .GoIdent.GoName }}FakeColumns are the columns fake repositories can order {{ .GoIdent.GoName }}s by
+var {{ .GoIdent.GoName }}FakeColumns = map[string]func(*{{ .Model.Name }}) interface{}{
+	{{- range .Model.OrderColumns }}
+	"{{ .Name }}": func(model *{{ $message.Model.Name }}) interface{} { return model.{{ .GoName }} },
+	{{- end }}
+}
+
+func (r *{{ .GoIdent.GoName }}FakeRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) ({{ .GoIdent.GoName }}Protos, error) {
+	{{- if .Model.TenantField }}
+	tenant, ok := gormtypes.TenantFromContext(ctx)
+	if !ok {
+		return nil, gormtypes.ErrMissingTenant
+	}
+	{{- end }}
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*{{ .Model.Name }}{}
+	for _, id := range lo.Uniq(ids) {
+		if row, ok := r.store.tables["{{ .Model.TableName }}"][id]; ok {
+			{{- if .Model.TenantField }}
+			if row.(*{{ .Model.Name }}).GetModelTenant() != tenant {
+				continue
+			}
+			{{- end }}
+			models = append(models, row.(*{{ .Model.Name }}))
+		}
+	}
+	return r.toProtos(models)
+}
+
+func (r *{{ .GoIdent.GoName }}FakeRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) ({{ .GoIdent.GoName }}Protos, error) {
+	{{- if .Model.TenantField }}
+	tenant, ok := gormtypes.TenantFromContext(ctx)
+	if !ok {
+		return nil, gormtypes.ErrMissingTenant
+	}
+	{{- end }}
+	r.store.mutex.RLock()
+	defer r.store.mutex.RUnlock()
+	models := []*{{ .Model.Name }}{}
+	for _, row := range r.store.tables["{{ .Model.TableName }}"] {
+		{{- if .Model.TenantField }}
+		if row.(*{{ .Model.Name }}).GetModelTenant() != tenant {
+			continue
+		}
+		{{- end }}
+		models = append(models, row.(*{{ .Model.Name }}))
+	}
+	if err := fakeOrder(models, order, {{ .GoIdent.GoName }}FakeColumns); err != nil {
+		return nil, err
+	}
+	return r.toProtos(fakePage(models, limit, offset))
+}
+
+{{- if not .Model.View }}
+
+func (r *{{ .GoIdent.GoName }}FakeRepository) Upsert(ctx context.Context, protos {{ .GoIdent.GoName }}Protos) ({{ .GoIdent.GoName }}Protos, error) {
+	{{- if .Model.TenantField }}
+	tenant, ok := gormtypes.TenantFromContext(ctx)
+	if !ok {
+		return nil, gormtypes.ErrMissingTenant
+	}
+	{{- end }}
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	stored := {{ .GoIdent.GoName }}Protos{}
+	for _, theProto := range protos {
+		if theProto.Id == nil {
+			theProto.Id = lo.ToPtr(uuid.New().String())
+		}
+		{{- if .Model.TenantField }}
+		switch {{ .Model.TenantOf "theProto" }} {
+		case "":
+			{{ .Model.SetTenant "theProto" "tenant" }}
+		case tenant:
+		default:
+			return nil, gormtypes.ErrTenantMismatch
+		}
+		// like the gorm upsert, rows of another tenant aren't updated
+		if existing, ok := r.store.tables["{{ .Model.TableName }}"][*theProto.Id]; ok && existing.(*{{ .Model.Name }}).GetModelTenant() != tenant {
+			return nil, gormtypes.ErrTenantMismatch
+		}
+		{{- end }}
+		stored = append(stored, proto.Clone(theProto).(*{{ goIdent .GoIdent }}))
+	}
+	models, err := stored.ToModels()
+	if err != nil {
+		return nil, err
+	}
+	{{- if or (.Model.HasTimestamp "CreatedAt") (.Model.HasTimestamp "UpdatedAt") }}
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	{{- end }}
+	for {{ if .Model.UpsertDoNothing }}i{{ else }}_{{ end }}, model := range models {
+		{{- if .Model.ConflictTargetFields }}
+		// like the gorm upsert, the row the model conflicts with is updated in its place
+		for _, id := range fakeSortedKeys(r.store.tables["{{ .Model.TableName }}"]) {
+			if existing := r.store.tables["{{ .Model.TableName }}"][id].(*{{ .Model.Name }}); fakeConflicts(r.conflictValues(existing), r.conflictValues(model)) {
+				model.Id = existing.Id
+				break
+			}
+		}
+		{{- end }}
+		{{- if or .Model.UpsertDoNothing (.Model.HasTimestamp "CreatedAt") .Model.ImmutableFields }}
+		existing, exists := r.store.tables["{{ .Model.TableName }}"][*model.Id].(*{{ .Model.Name }})
+		{{- end }}
+		{{- if .Model.UpsertDoNothing }}
+		if exists {
+			// existing rows are left as they are
+			models[i] = existing
+			continue
+		}
+		{{- end }}
+		{{- range .Model.Fields }}
+		{{- if .IsAssociation }}
+		model.{{ .GoName }} = nil
+		{{- end }}
+		{{- end }}
+		{{- if and (.Model.HasTimestamp "CreatedAt") .Model.UpsertDoNothing }}
+		if model.CreatedAt == nil {
+			model.CreatedAt = &now
+		}
+		{{- else if .Model.HasTimestamp "CreatedAt" }}
+		if exists {
+			model.CreatedAt = existing.CreatedAt
+		} else if model.CreatedAt == nil {
+			model.CreatedAt = &now
+		}
+		{{- end }}
+		{{- if and .Model.ImmutableFields (not .Model.UpsertDoNothing) }}
+		if exists {
+			// immutable fields are left as they are
+			{{- range .Model.ImmutableFields }}
+			model.{{ .GoName }} = existing.{{ .GoName }}
+			{{- end }}
+		}
+		{{- end }}
+		{{- if .Model.HasTimestamp "UpdatedAt" }}
+		if model.UpdatedAt == nil {
+			model.UpdatedAt = &now
+		}
+		{{- end }}
+		r.store.tables["{{ .Model.TableName }}"][*model.Id] = model
+	}
+	// like the gorm upsert, the protos are updated with the rows as stored
+	if stored, err = r.toProtos(models); err != nil {
+		return nil, err
+	}
+	for i, theProto := range protos {
+		proto.Reset(theProto)
+		proto.Merge(theProto, stored[i])
+	}
+	return protos, nil
+}
+
+func (r *{{ .GoIdent.GoName }}FakeRepository) Delete(ctx context.Context, ids []string) ({{ .GoIdent.GoName }}Protos, error) {
+	{{- if .Model.TenantField }}
+	tenant, ok := gormtypes.TenantFromContext(ctx)
+	if !ok {
+		return nil, gormtypes.ErrMissingTenant
+	}
+	{{- end }}
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	models := {{ .Model.Name }}s{}
+	for _, id := range ids {
+		row, ok := r.store.tables["{{ .Model.TableName }}"][id]
+		if !ok {{- if .Model.TenantField }} || row.(*{{ .Model.Name }}).GetModelTenant() != tenant{{ end }} {
+			continue
+		}
+		models = append(models, row.(*{{ .Model.Name }}))
+		delete(r.store.tables["{{ .Model.TableName }}"], id)
+		r.store.deleteAssociations("{{ .Model.TableName }}", id)
+	}
+	// like the gorm delete, the deleted rows are returned without their associations
+	return models.ToProtos()
+}
+{{- end }}
+
+{{ if .Model.ConflictTargetFields -}}
+// conflictValues returns the values of the model's conflict target, see {{ .Model.Name }}.OnConflict
+func (r *{{ .GoIdent.GoName }}FakeRepository) conflictValues(model *{{ .Model.Name }}) []interface{} {
+	return []interface{}{ {{- range $index, $name := .Model.ConflictTargetFields }}{{ if $index }}, {{ end }}model.{{ $name }}{{ end -}} }
+}
+
+{{ end -}}
+// toProtos converts the stored models, and loads their associations from the store
+func (r *{{ .GoIdent.GoName }}FakeRepository) toProtos(models []*{{ .Model.Name }}) (protos {{ .GoIdent.GoName }}Protos, err error) {
+	protos = {{ .GoIdent.GoName }}Protos{}
+	for _, model := range models {
+		var theProto *{{ goIdent .GoIdent }}
+		if theProto, err = model.ToProto(); err != nil {
+			return
+		}
+		theProto = proto.Clone(theProto).(*{{ goIdent .GoIdent }})
+		{{- range .Model.FakeAssociations }}
+		{{- if eq .Kind "belongs_to" }}
+		if row, ok := r.store.tables["{{ .Table }}"][{{ .ForeignKeyOf "model" }}]; ok {
+			if theProto.{{ .GoName }}, err = row.(*{{ .ModelName }}).ToProto(); err != nil {
+				return
+			}
+		}
+		{{- else if eq .Kind "has_one" }}
+		for _, id := range fakeSortedKeys(r.store.tables["{{ .Table }}"]) {
+			if related := r.store.tables["{{ .Table }}"][id].(*{{ .ModelName }}); {{ .ForeignKeyOf "related" }} == *model.Id {
+				if theProto.{{ .GoName }}, err = related.ToProto(); err != nil {
+					return
+				}
+				break
+			}
+		}
+		{{- else if eq .Kind "has_many" }}
+		for _, id := range fakeSortedKeys(r.store.tables["{{ .Table }}"]) {
+			if related := r.store.tables["{{ .Table }}"][id].(*{{ .ModelName }}); {{ .ForeignKeyOf "related" }} == *model.Id {
+				relatedProto, err := related.ToProto()
+				if err != nil {
+					return nil, err
+				}
+				theProto.{{ .GoName }} = append(theProto.{{ .GoName }}, relatedProto)
+			}
+		}
+		{{- else if eq .Kind "many_to_many" }}
+		for _, id := range fakeSortedKeys(r.store.joinTables["{{ .JoinTable }}"][*model.Id]) {
+			if row, ok := r.store.tables["{{ .Table }}"][id]; ok {
+				relatedProto, err := row.(*{{ .ModelName }}).ToProto()
+				if err != nil {
+					return nil, err
+				}
+				theProto.{{ .GoName }} = append(theProto.{{ .GoName }}, relatedProto)
+			}
+		}
+		{{- end }}
+		{{- end }}
+		protos = append(protos, theProto)
+	}
+	return
+}
+{{ range .Model.FakeAssociations }}
+{{- if eq .Kind "many_to_many" }}
+func (r *{{ $message.GoIdent.GoName }}FakeRepository) Associate{{ .GoName }}(ctx context.Context, associations *ManyToManyAssociations) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	joinTable := r.store.joinTables["{{ .JoinTable }}"]
+	for id, associatedIds := range associations.Associations() {
+		if joinTable[id] == nil {
+			joinTable[id] = map[string]bool{}
+		}
+		for _, associatedId := range associatedIds {
+			joinTable[id][associatedId] = true
+		}
+	}
+	return nil
+}
+
+func (r *{{ $message.GoIdent.GoName }}FakeRepository) Dissociate{{ .GoName }}(ctx context.Context, associations *ManyToManyAssociations) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	joinTable := r.store.joinTables["{{ .JoinTable }}"]
+	for id, associatedIds := range associations.Associations() {
+		for _, associatedId := range associatedIds {
+			delete(joinTable[id], associatedId)
+		}
+	}
+	return nil
+}
+
+func (r *{{ $message.GoIdent.GoName }}FakeRepository) Replace{{ .GoName }}(ctx context.Context, associations *ManyToManyAssociations) error {
+	r.store.mutex.Lock()
+	defer r.store.mutex.Unlock()
+	joinTable := r.store.joinTables["{{ .JoinTable }}"]
+	for id, associatedIds := range associations.Associations() {
+		joinTable[id] = map[string]bool{}
+		for _, associatedId := range associatedIds {
+			joinTable[id][associatedId] = true
+		}
+	}
+	return nil
+}
+{{ end }}
+{{- end }}
+`))
@@ -4,22 +4,25 @@ import "text/template"
 
 var genericsTemplate = template.Must(template.New("generics").Funcs(genericsTemplateFuncs).Parse(`
 {{ $messages := .messages }}
-// Protos is a union of other types that defines which types may be used in generic functions
+{{- $tables := .tables }}
+// Protos is a union of other types that defines which types may be used in generic functions. The protos of views are
+// left out, they're read only
 type Protos interface {
-	{{ range $i, $m := .messages -}}
+	{{ range $i, $m := .tables -}}
 	{{ if not .Ignore -}}
-	*{{ .GoIdent.GoName }} {{- if pipe $i $messages -}} | {{- end -}}
+	*{{ .GoIdent.GoName }} {{- if pipe $i $tables -}} | {{- end -}}
 	{{ end -}}
 	{{ end }}
 	GetProtoId() *string
 	SetProtoId(string)
 }
 
-// Models is a union of other types that defines which types may be used in generic functions
+// Models is a union of other types that defines which types may be used in generic functions. The models of views are
+// left out, they're read only
 type Models interface {
-	{{ range $i, $m := .messages -}}
+	{{ range $i, $m := .tables -}}
 	{{ if not .Ignore -}}
-	*{{ .GoIdent.GoName }}GormModel {{- if pipe $i $messages -}} | {{- end -}}
+	*{{ .GoIdent.GoName }}GormModel {{- if pipe $i $tables -}} | {{- end -}}
 	{{ end -}}
 	{{ end }}
 	GetModelId() *string
@@ -28,6 +31,19 @@ type Models interface {
 	TableName() string // tabler interface for gorm model, gives us access to the table name that gorm will use, see https://gorm.io/docs/conventions.html#TableName
 }
 
+// ReadModels is a union of the Models and the models of views, which may be used in the generic functions that only
+// read rows
+type ReadModels interface {
+	{{ range $i, $m := .messages -}}
+	{{ if not .Ignore -}}
+	*{{ .GoIdent.GoName }}GormModel {{- if pipe $i $messages -}} | {{- end -}}
+	{{ end -}}
+	{{ end }}
+	GetModelId() *string
+	New() interface{}
+	TableName() string
+}
+
 // Proto[M Models] is an interface type that defines behavior for the implementer of a given Models type
 type Proto[M Models] interface {
 	GetProtoId() *string
@@ -39,7 +55,214 @@ type Proto[M Models] interface {
 type Model[P Protos] interface {
 	ToProto() (P, error)
 }
+{{ if .tenants }}
+// TenantModel is implemented by the models of messages with a tenant column, the generic functions scope them to the
+// tenant of the context, see gormtypes.WithTenant
+type TenantModel interface {
+	TenantColumn() string
+	GetModelTenant() string
+	SetModelTenant(string)
+}
+
+// ScopeTenant scopes the statement to the rows of the context's tenant when M has a tenant column
+func ScopeTenant[M ReadModels](ctx context.Context, statement *gorm.DB) (*gorm.DB, error) {
+	var temp M
+	model, ok := temp.New().(TenantModel)
+	if !ok {
+		return statement, nil
+	}
+	tenant, ok := gormtypes.TenantFromContext(ctx)
+	if !ok {
+		return nil, gormtypes.ErrMissingTenant
+	}
+	return statement.Where(fmt.Sprintf("%s.%s = ?", temp.TableName(), model.TenantColumn()), tenant), nil
+}
+
+{{- if .rowLevelSecurity }}
+// RowLevelSecurityModel is implemented by the models of messages with the tenant_row_level_security option, whose
+// statements run in a transaction setting the tenant their table's policy allows, see WithTenantSetting
+type RowLevelSecurityModel interface {
+	RowLevelSecurity() bool
+}
+
+// SetTenantSetting sets the context's tenant for the rest of the transaction when M has row level security, so its
+// table's policy allows the tenant's rows, see gormtypes.SetTenantSetting
+func SetTenantSetting[M ReadModels](ctx context.Context, tx *gorm.DB) error {
+	var temp M
+	if model, ok := temp.New().(RowLevelSecurityModel); !ok || !model.RowLevelSecurity() {
+		return nil
+	}
+	tenant, ok := gormtypes.TenantFromContext(ctx)
+	if !ok {
+		return gormtypes.ErrMissingTenant
+	}
+	return gormtypes.SetTenantSetting(tx, tenant)
+}
+
+// WithTenantSetting runs fn in a transaction setting the context's tenant first when M has row level security, see
+// SetTenantSetting, otherwise fn runs with the db
+func WithTenantSetting[M ReadModels](ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error) error {
+	var temp M
+	if model, ok := temp.New().(RowLevelSecurityModel); !ok || !model.RowLevelSecurity() {
+		return fn(db)
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		if err := SetTenantSetting[M](ctx, tx); err != nil {
+			return err
+		}
+		return fn(tx)
+	})
+}
+{{ end }}
+// AssignTenant sets the context's tenant on the models without one when M has a tenant column, models of another tenant
+// are an error
+func AssignTenant[M Models](ctx context.Context, models []M) error {
+	var temp M
+	if _, ok := temp.New().(TenantModel); !ok {
+		return nil
+	}
+	tenant, ok := gormtypes.TenantFromContext(ctx)
+	if !ok {
+		return gormtypes.ErrMissingTenant
+	}
+	for _, model := range models {
+		tenantModel := any(model).(TenantModel)
+		switch tenantModel.GetModelTenant() {
+		case "":
+			tenantModel.SetModelTenant(tenant)
+		case tenant:
+		default:
+			return gormtypes.ErrTenantMismatch
+		}
+	}
+	return nil
+}
+{{ end }}
+{{ if .conflicts }}
+// ConflictModel is implemented by the models of messages with conflict_columns or upsert_do_nothing, upserts use their
+// on conflict clause
+type ConflictModel interface {
+	OnConflict() clause.OnConflict
+}
+
+// UpsertIds returns the ids of the rows an upsert of the models writes, the models' ids and the ids of the rows they
+// conflict with on M's conflict columns, which the upsert updates in their place. The rows they conflict with are
+// locked, so the history and outbox records of the upsert find them before and after the write
+func UpsertIds[M Models](tx *gorm.DB, models []M) ([]string, error) {
+	ids := []string{}
+	for _, model := range models {
+		ids = append(ids, *model.GetModelId())
+	}
+	var temp M
+	conflictModel, ok := temp.New().(ConflictModel)
+	if !ok {
+		return ids, nil
+	}
+	columns := lo.Map(conflictModel.OnConflict().Columns, func(column clause.Column, _ int) string { return column.Name })
+	if len(columns) == 0 || (len(columns) == 1 && columns[0] == "id") {
+		return ids, nil
+	}
+	values, err := conflictValues(tx, models, columns)
+	if err != nil {
+		return nil, err
+	}
+	condition := fmt.Sprintf("(%s) IN ?", strings.Join(columns, ", "))
+	for _, batch := range lo.Chunk(values, maxBindParameters/len(columns)) {
+		conflicting := []string{}
+		err = tx.Model(temp.New()).Clauses(clause.Locking{Strength: "UPDATE"}).Where(condition, batch).Pluck("id", &conflicting).Error
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, conflicting...)
+	}
+	return lo.Uniq(ids), nil
+}
+
+// conflictValues returns the values of the models' columns, by model
+func conflictValues[M Models](tx *gorm.DB, models []M, columns []string) ([][]interface{}, error) {
+	modelSchema, err := parseModel[M](tx)
+	if err != nil {
+		return nil, err
+	}
+	values := [][]interface{}{}
+	for _, model := range models {
+		row := []interface{}{}
+		for _, column := range columns {
+			value, _ := modelSchema.LookUpField(column).ValueOf(tx.Statement.Context, reflect.Indirect(reflect.ValueOf(model)))
+			row = append(row, value)
+		}
+		values = append(values, row)
+	}
+	return values, nil
+}
+
+// refreshConflicting sets the models to the stored rows with their values of the columns, as upserts doing nothing on
+// conflict don't return the rows they leave as they are. The values are read before the upsert, which may scan the rows
+// it returns into other models
+func refreshConflicting[M Models](tx *gorm.DB, models []M, columns []string, values [][]interface{}) error {
+	key := func(row []interface{}) string {
+		return fmt.Sprint(lo.Map(row, func(value interface{}, _ int) interface{} {
+			if indirect := reflect.Indirect(reflect.ValueOf(value)); indirect.IsValid() {
+				return indirect.Interface()
+			}
+			return nil
+		}))
+	}
+	condition := fmt.Sprintf("(%s) IN ?", strings.Join(columns, ", "))
+	stored := map[string]M{}
+	for _, batch := range lo.Chunk(values, maxBindParameters/len(columns)) {
+		rows := []M{}
+		if err := tx.Where(condition, batch).Find(&rows).Error; err != nil {
+			return err
+		}
+		rowValues, err := conflictValues(tx, rows, columns)
+		if err != nil {
+			return err
+		}
+		for i, row := range rows {
+			stored[key(rowValues[i])] = row
+		}
+	}
+	for i, model := range models {
+		if row, ok := stored[key(values[i])]; ok {
+			reflect.ValueOf(model).Elem().Set(reflect.ValueOf(row).Elem())
+		}
+	}
+	return nil
+}
+{{ end }}
+{{- if .history }}
+// HistoryModel is implemented by the models of messages with the history option
+type HistoryModel interface {
+	RecordHistory(ctx context.Context, tx *gorm.DB, ids []string, write func(tx *gorm.DB) error) error
+}
 
+// RecordHistory runs the write, in a transaction recording the changes it made to the rows with the given ids when M
+// has the history option
+func RecordHistory[M Models](ctx context.Context, tx *gorm.DB, ids []string, write func(tx *gorm.DB) error) error {
+	var temp M
+	if model, ok := temp.New().(HistoryModel); ok {
+		return model.RecordHistory(ctx, tx, ids, write)
+	}
+	return write(tx)
+}
+{{ end }}
+{{- if .outbox }}
+// OutboxModel is implemented by the models of messages with the outbox option
+type OutboxModel interface {
+	RecordOutbox(ctx context.Context, tx *gorm.DB, ids []string, write func(tx *gorm.DB) error) error
+}
+
+// RecordOutbox runs the write, in a transaction inserting an outbox event for each of the rows with the given ids it
+// changed when M has the outbox option
+func RecordOutbox[M Models](ctx context.Context, tx *gorm.DB, ids []string, write func(tx *gorm.DB) error) error {
+	var temp M
+	if model, ok := temp.New().(OutboxModel); ok {
+		return model.RecordOutbox(ctx, tx, ids, write)
+	}
+	return write(tx)
+}
+{{ end }}
 // ToModels converts an array of protos to an array of gorm db models by calling the proto's ToModel method
 func ToModels[P Protos, M Models](protos interface{}) ([]M, error) {
 	converted := ConvertProtosToProtosM[P, M](protos)
@@ -47,7 +270,7 @@ func ToModels[P Protos, M Models](protos interface{}) ([]M, error) {
 	for _, proto := range converted {
 		model, err := proto.ToModel()
 		if err != nil {
-			return nil, err
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		models = append(models, model)
 	}
@@ -93,18 +316,146 @@ func ToProtos[P Protos, M Models](models interface{}) ([]P, error) {
 	for _, model := range converted {
 		proto, err := model.ToProto()
 		if err != nil {
-			return nil, err
+			return nil, &gormtypes.ConversionError{Err: err}
 		}
 		protos = append(protos, proto)
 	}
 	return protos, nil
 }
 
+// maxBindParameters is the most bind parameters postgres and cockroachdb allow in a statement
+const maxBindParameters = 65535
+
+// parseModel parses the gorm schema of M's model
+func parseModel[M Models](db *gorm.DB) (*schema.Schema, error) {
+	var temp M
+	statement := &gorm.Statement{DB: db}
+	if err := statement.Parse(temp.New()); err != nil {
+		return nil, err
+	}
+	return statement.Schema, nil
+}
+
+// returningColumns returns a returning clause of the columns of M's model
+func returningColumns[M Models](db *gorm.DB) (clause.Returning, error) {
+	modelSchema, err := parseModel[M](db)
+	if err != nil {
+		return clause.Returning{}, err
+	}
+	return clause.Returning{Columns: lo.Map(modelSchema.DBNames, func(name string, _ int) clause.Column {
+		return clause.Column{Name: name}
+	})}, nil
+}
+
+// UpsertBatchSize returns the number of models of M upserts insert per statement, the most whose columns fit within the
+// bind parameter limit, or the model's upsert_batch_size option when it's smaller
+func UpsertBatchSize[M Models](db *gorm.DB) (int, error) {
+	modelSchema, err := parseModel[M](db)
+	if err != nil {
+		return 0, err
+	}
+	size := maxBindParameters / len(modelSchema.DBNames)
+	var temp M
+	if batched, ok := temp.New().(interface{ UpsertBatchSize() int }); ok && batched.UpsertBatchSize() < size {
+		size = batched.UpsertBatchSize()
+	}
+	return size, nil
+}
+
+// CreateInBatches creates the models with the statement, in batches of UpsertBatchSize models. Several batches are
+// created in one transaction, so either all or none of the models are created. The models are updated with the rows as
+// stored, which the database returns where it supports returning. On conflict clauses doing nothing don't return the
+// rows they leave as they are, so the models are refreshed from the rows stored with their conflict columns' values
+// instead. It returns the number of affected rows
+func CreateInBatches[M Models](statement *gorm.DB, models []M) (int64, error) {
+	size, err := UpsertBatchSize[M](statement)
+	if err != nil {
+		return 0, err
+	}
+	{{- if .conflicts }}
+	onConflict, _ := statement.Statement.Clauses["ON CONFLICT"].Expression.(clause.OnConflict)
+	columns := lo.Map(onConflict.Columns, func(column clause.Column, _ int) string { return column.Name })
+	var values [][]interface{}
+	if onConflict.DoNothing && len(columns) > 0 {
+		if values, err = conflictValues(statement, models, columns); err != nil {
+			return 0, err
+		}
+	}
+	{{- end }}
+	// returning the columns rather than * updates the models in place instead of replacing them
+	returning, err := returningColumns[M](statement)
+	if err != nil {
+		return 0, err
+	}
+	// a new session keeps the statement's clauses, and can be used for several statements
+	statement = statement.Session(&gorm.Session{}).Clauses(returning)
+	var rowsAffected int64
+	if len(models) <= size {
+		result := statement.Create(&models)
+		rowsAffected, err = result.RowsAffected, result.Error
+	} else {
+		err = statement.Transaction(func(tx *gorm.DB) error {
+			for _, batch := range lo.Chunk(models, size) {
+				result := tx.Create(&batch)
+				if result.Error != nil {
+					return result.Error
+				}
+				rowsAffected += result.RowsAffected
+			}
+			return nil
+		})
+	}
+	{{- if .conflicts }}
+	if err == nil && values != nil {
+		err = refreshConflicting(statement.Session(&gorm.Session{NewDB: true}), models, columns, values)
+	}
+	{{- end }}
+	return rowsAffected, err
+}
+
+// DeleteReturning deletes the rows the statement selects, returning them as models. Postgres and cockroachdb return the
+// deleted rows, other databases select the rows before deleting them in a transaction
+func DeleteReturning[M Models](statement *gorm.DB) ([]M, error) {
+	models := []M{}
+	if statement.Dialector.Name() == "postgres" {
+		returning, err := returningColumns[M](statement)
+		if err != nil {
+			return nil, err
+		}
+		err = statement.Clauses(returning).Delete(&models).Error
+		return models, err
+	}
+	err := statement.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Find(&models).Error; err != nil || len(models) == 0 {
+			return err
+		}
+		return tx.Delete(&models).Error
+	})
+	return models, err
+}
+
+// RefreshProtos sets the protos to the models converted to protos, e.g. to the rows upserts return as stored
+func RefreshProtos[P Protos, M Models](protos []P, models []M) error {
+	for i, model := range models {
+		stored, err := ConvertModelToModelP[P, M](model).ToProto()
+		if err != nil {
+			return &gormtypes.ConversionError{Err: err}
+		}
+		message := any(protos[i]).(proto.Message)
+		proto.Reset(message)
+		proto.Merge(message, any(stored).(proto.Message))
+	}
+	return nil
+}
+
+// TxCallback is called by the generic Upsert and Delete with their transaction and the upserted or deleted models
+type TxCallback[M Models] func(tx *gorm.DB, models []M) error
+
 // Upsert is a generic function that will upsert any of the generated protos, returning the upserted models. Upsert
-// excludes all associations, and uses an on conflict clause to handle upsert. A function may be provided to be executed
-// during the transaction. The function is executed after the upsert. If the function returns an error, the transaction
+// excludes all associations, and uses an on conflict clause to handle upsert. Callbacks may be provided to be executed
+// during the transaction. The callbacks are executed after the upsert. If a callback returns an error, the transaction
 // will be rolled back.
-func Upsert[P Protos, M Models](ctx context.Context, db *gorm.DB, protos interface{}) ([]M, error) {
+func Upsert[P Protos, M Models](ctx context.Context, db *gorm.DB, protos interface{}, callbacks ...TxCallback[M]) ([]M, error) {
 	converted := ConvertProtosToProtosM[P, M](protos)
 	if len(converted) > 0 {
 		models := []M{}
@@ -114,40 +465,151 @@ func Upsert[P Protos, M Models](ctx context.Context, db *gorm.DB, protos interfa
 			}
 			model, err := proto.ToModel()
 			if err != nil {
-				return nil, err
+				return nil, &gormtypes.ConversionError{Err: err}
 			}
 			models = append(models, model)
 		}
-		session := db.Session(&gorm.Session{})
-		err := session.
-			// on conflict, update all fields
-			Clauses(clause.OnConflict{
-				UpdateAll: true,
-			}).
-			// exclude associations from upsert
-			Omit(clause.Associations).
-			Create(&models).Error
-
-		return models, err
+		// on conflict, update all fields
+		onConflict := clause.OnConflict{UpdateAll: true}
+		{{- if or .tenants .conflicts }}
+		var temp M
+		{{- end }}
+		{{- if .conflicts }}
+		// or use the model's on conflict clause when it has conflict options
+		if conflictModel, ok := temp.New().(ConflictModel); ok {
+			onConflict = conflictModel.OnConflict()
+		}
+		{{- end }}
+		{{- if .tenants }}
+		if err := AssignTenant(ctx, models); err != nil {
+			return nil, err
+		}
+		// only update rows of the same tenant when M has a tenant column
+		tenantModel, scoped := temp.New().(TenantModel)
+		if scoped {
+			column := tenantModel.TenantColumn()
+			{{- if .conflicts }}
+			if onConflict.DoNothing {
+				// do nothing can't be limited to the rows of the tenant, and doesn't count the rows it leaves as affected,
+				// so a conflict column is set to its own value instead, which leaves the row as it is but returns it
+				onConflict = clause.OnConflict{Columns: onConflict.Columns,
+					DoUpdates: clause.AssignmentColumns([]string{onConflict.Columns[0].Name})}
+			}
+			{{- end }}
+			onConflict.Where = clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: fmt.Sprintf("%s.%s = excluded.%s", temp.TableName(), column, column)}}}
+		}
+		{{- end }}
+		upsert := func(session *gorm.DB) error {
+			{{ if .tenants }}rowsAffected{{ else }}_{{ end }}, err := CreateInBatches(session.
+				Clauses(onConflict).
+				// exclude associations from upsert
+				Omit(clause.Associations), models)
+			{{- if .tenants }}
+			// rows of another tenant aren't updated, so they aren't affected
+			if err == nil && scoped && rowsAffected < int64(len(models)) {
+				return gormtypes.ErrTenantMismatch
+			}
+			{{- end }}
+			return err
+		}
+		{{- if or .history .outbox }}
+		var ids []string
+		{{- if not .conflicts }}
+		for _, model := range models {
+			ids = append(ids, *model.GetModelId())
+		}
+		{{- end }}
+		{{- end }}
+		{{- if .outbox }}
+		write := upsert
+		upsert = func(tx *gorm.DB) error {
+			return RecordOutbox[M](ctx, tx, ids, write)
+		}
+		{{- end }}
+		err := db.Session(&gorm.Session{}).Transaction(func(tx *gorm.DB) error {
+			{{- if .rowLevelSecurity }}
+			if err := SetTenantSetting[M](ctx, tx); err != nil {
+				return err
+			}
+			{{- end }}
+			{{- if and .conflicts (or .history .outbox) }}
+			// the rows the models conflict with are updated in their place, so their ids are recorded too
+			var err error
+			if ids, err = UpsertIds(tx, models); err != nil {
+				return err
+			}
+			{{- end }}
+			{{- if .history }}
+			if err := RecordHistory[M](ctx, tx, ids, upsert); err != nil {
+			{{- else }}
+			if err := upsert(tx); err != nil {
+			{{- end }}
+				return err
+			}
+			return runCallbacks(tx, models, callbacks)
+		})
+		if err == nil {
+			// update the protos with the models, which are the rows as stored
+			err = RefreshProtos(protos.([]P), models)
+		}
+		return models, gormtypes.ClassifyError(err)
 	}
 	return nil, nil
 }
 
-// Delete is a generic function that will delete any of the generated protos. A function may be provided to be executed
-// during the transaction. The function is executed after the delete. If the function returns an error, the transaction
+// runCallbacks calls the callbacks with the transaction and the models in order, until one of them returns an error
+func runCallbacks[M Models](tx *gorm.DB, models []M, callbacks []TxCallback[M]) error {
+	for _, callback := range callbacks {
+		if err := callback(tx, models); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Delete is a generic function that will delete any of the generated protos, returning the deleted models. Callbacks may be provided to be executed
+// during the transaction. The callbacks are executed after the delete. If a callback returns an error, the transaction
 // will be rolled back.
-func Delete[M Models](ctx context.Context, db *gorm.DB, ids []string) ([]M, error) {
+func Delete[M Models](ctx context.Context, db *gorm.DB, ids []string, callbacks ...TxCallback[M]) ([]M, error) {
 	if len(ids) > 0 {
-		session := db.Session(&gorm.Session{})
-		models := []M{}
-		err := session.Where("id in ?", ids).Delete(&models).Error
-		return models, err
+		var models []M
+		remove := func(session *gorm.DB) (err error) {
+			{{- if .tenants }}
+			if session, err = ScopeTenant[M](ctx, session); err != nil {
+				return
+			}
+			{{- end }}
+			models, err = DeleteReturning[M](session.Where("id in ?", ids))
+			return
+		}
+		{{- if .outbox }}
+		write := remove
+		remove = func(tx *gorm.DB) error {
+			return RecordOutbox[M](ctx, tx, ids, write)
+		}
+		{{- end }}
+		err := db.Session(&gorm.Session{}).Transaction(func(tx *gorm.DB) error {
+			{{- if .rowLevelSecurity }}
+			if err := SetTenantSetting[M](ctx, tx); err != nil {
+				return err
+			}
+			{{- end }}
+			{{- if .history }}
+			if err := RecordHistory[M](ctx, tx, ids, remove); err != nil {
+			{{- else }}
+			if err := remove(tx); err != nil {
+			{{- end }}
+				return err
+			}
+			return runCallbacks(tx, models, callbacks)
+		})
+		return models, gormtypes.ClassifyError(err)
 	}
 	return nil, nil
 }
 
 // List lists the given model type
-func List[M Models](ctx context.Context, db *gorm.DB, limit, offset int, orderBy string, preloads map[string][]interface{}) ([]M, error) {
+func List[M ReadModels](ctx context.Context, db *gorm.DB, limit, offset int, orderBy string, preloads map[string][]interface{}) ([]M, error) {
 	session := db.Session(&gorm.Session{}).WithContext(ctx)
 	// set limit
 	if limit > 0 {
@@ -165,22 +627,56 @@ func List[M Models](ctx context.Context, db *gorm.DB, limit, offset int, orderBy
 	if orderBy != "" {
 		session = session.Order(orderBy)
 	}
+	{{- if .tenants }}
+	session, err := ScopeTenant[M](ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	{{- end }}
 	// execute
 	var models []M
-	err := session.Find(&models).Error
-	return models, err
+	{{- if .rowLevelSecurity }}
+	err = WithTenantSetting[M](ctx, session, func(tx *gorm.DB) error { return tx.Find(&models).Error })
+	{{- else }}
+	err {{ if not .tenants }}:{{ end }}= session.Find(&models).Error
+	{{- end }}
+	return models, gormtypes.ClassifyError(err)
 }
 
 // GetByIds gets the given model type by id
-func GetByIds[M Models](ctx context.Context, db *gorm.DB, ids []string, preloads map[string][]interface{}) ([]M, error) {
+func GetByIds[M ReadModels](ctx context.Context, db *gorm.DB, ids []string, preloads map[string][]interface{}) ([]M, error) {
 	session := db.Session(&gorm.Session{}).WithContext(ctx)
 	// set preloads
 	for preload, args := range preloads {
 		session = session.Preload(preload, args...)
 	}
+	{{- if .tenants }}
+	session, err := ScopeTenant[M](ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	{{- end }}
 	models := []M{}
-	err := session.Where("id in ?", ids).Find(&models).Error
-	return models, err
+	{{- if .rowLevelSecurity }}
+	err = WithTenantSetting[M](ctx, session, func(tx *gorm.DB) error { return tx.Where("id in ?", ids).Find(&models).Error })
+	{{- else }}
+	err {{ if not .tenants }}:{{ end }}= session.Where("id in ?", ids).Find(&models).Error
+	{{- end }}
+	return models, gormtypes.ClassifyError(err)
+}
+
+// Get gets the given model type by id, returning a gormtypes.NotFoundError, which errors.Is matches with
+// gormtypes.ErrNotFound, when it doesn't exist
+func Get[M ReadModels](ctx context.Context, db *gorm.DB, id string, preloads map[string][]interface{}) (M, error) {
+	var model M
+	models, err := GetByIds[M](ctx, db, []string{id}, preloads)
+	if err != nil {
+		return model, err
+	}
+	if len(models) == 0 {
+		return model, &gormtypes.NotFoundError{Err: gorm.ErrRecordNotFound}
+	}
+	return models[0], nil
 }
 
 // ManyToManyAssociations is a sync map with helper functions. I'm using a sync.map so that it's thread safe, and
@@ -0,0 +1,74 @@
+package plugin
+
+// googleTypeFieldTemplates converts google.type fields between protos and models, they're parsed with fieldTemplates
+const googleTypeFieldTemplates = `
+{{ define "googleTypeToProto" }}
+	{{ if eq .GoogleType "date" }}
+	if m.{{ .GoName }} != nil {
+		theProto.{{ .GoName }} = &{{ .GoogleTypeGoIdent }}{Year: int32(m.{{ .GoName }}.Year()), Month: int32(m.{{ .GoName }}.Month()), Day: int32(m.{{ .GoName }}.Day())}
+	}
+	{{ else if eq .GoogleType "time_of_day" }}
+	if m.{{ .GoName }} != nil {
+		var timeOfDay time.Time
+		if timeOfDay, err = time.Parse("15:04:05.999999999", *m.{{ .GoName }}); err != nil {
+			return
+		}
+		theProto.{{ .GoName }} = &{{ .GoogleTypeGoIdent }}{Hours: int32(timeOfDay.Hour()), Minutes: int32(timeOfDay.Minute()), Seconds: int32(timeOfDay.Second()), Nanos: int32(timeOfDay.Nanosecond())}
+	}
+	{{ else if eq .GoogleType "money" }}
+	if m.{{ .GoName }}Amount != nil {
+		theProto.{{ .GoName }} = &{{ .GoogleTypeGoIdent }}{CurrencyCode: lo.FromPtr(m.{{ .GoName }}Currency)}
+		if theProto.{{ .GoName }}.Units, theProto.{{ .GoName }}.Nanos, err = gormtypes.ParseMoneyAmount(*m.{{ .GoName }}Amount); err != nil {
+			return
+		}
+	}
+	{{ else if eq .GoogleType "decimal" }}
+	if m.{{ .GoName }} != nil {
+		theProto.{{ .GoName }} = &{{ .GoogleTypeGoIdent }}{Value: *m.{{ .GoName }}}
+	}
+	{{ else if .PostgisPoint }}
+	if m.{{ .GoName }} != nil {
+		theProto.{{ .GoName }} = &{{ .GoogleTypeGoIdent }}{Latitude: m.{{ .GoName }}.Latitude, Longitude: m.{{ .GoName }}.Longitude}
+	}
+	{{ else }}
+	if m.{{ .GoName }}Latitude != nil && m.{{ .GoName }}Longitude != nil {
+		theProto.{{ .GoName }} = &{{ .GoogleTypeGoIdent }}{Latitude: *m.{{ .GoName }}Latitude, Longitude: *m.{{ .GoName }}Longitude}
+	}
+	{{ end }}
+{{ end }}
+
+{{ define "googleTypeToModel" }}
+	{{ if eq .GoogleType "date" }}
+	if p.{{ .GoName }} != nil {
+		// a date column can't hold a date without a year, month or day
+		if p.{{ .GoName }}.Year == 0 || p.{{ .GoName }}.Month == 0 || p.{{ .GoName }}.Day == 0 {
+			err = fmt.Errorf("field {{ .Desc.FullName }}: partial dates can't be stored")
+			return
+		}
+		theModel.{{ .GoName }} = lo.ToPtr(time.Date(int(p.{{ .GoName }}.Year), time.Month(p.{{ .GoName }}.Month), int(p.{{ .GoName }}.Day), 0, 0, 0, 0, time.UTC))
+	}
+	{{ else if eq .GoogleType "time_of_day" }}
+	if p.{{ .GoName }} != nil {
+		theModel.{{ .GoName }} = lo.ToPtr(fmt.Sprintf("%02d:%02d:%02d.%09d", p.{{ .GoName }}.Hours, p.{{ .GoName }}.Minutes, p.{{ .GoName }}.Seconds, p.{{ .GoName }}.Nanos))
+	}
+	{{ else if eq .GoogleType "money" }}
+	if p.{{ .GoName }} != nil {
+		theModel.{{ .GoName }}Amount = lo.ToPtr(gormtypes.FormatMoneyAmount(p.{{ .GoName }}.Units, p.{{ .GoName }}.Nanos))
+		theModel.{{ .GoName }}Currency = lo.ToPtr(p.{{ .GoName }}.CurrencyCode)
+	}
+	{{ else if eq .GoogleType "decimal" }}
+	if p.{{ .GoName }} != nil {
+		theModel.{{ .GoName }} = lo.ToPtr(p.{{ .GoName }}.Value)
+	}
+	{{ else if .PostgisPoint }}
+	if p.{{ .GoName }} != nil {
+		theModel.{{ .GoName }} = &gormtypes.Point{Latitude: p.{{ .GoName }}.Latitude, Longitude: p.{{ .GoName }}.Longitude}
+	}
+	{{ else }}
+	if p.{{ .GoName }} != nil {
+		theModel.{{ .GoName }}Latitude = lo.ToPtr(p.{{ .GoName }}.Latitude)
+		theModel.{{ .GoName }}Longitude = lo.ToPtr(p.{{ .GoName }}.Longitude)
+	}
+	{{ end }}
+{{ end }}
+`
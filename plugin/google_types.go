@@ -0,0 +1,119 @@
+package plugin
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	googleTypeDate      = "date"
+	googleTypeTimeOfDay = "time_of_day"
+	googleTypeMoney     = "money"
+	googleTypeDecimal   = "decimal"
+	googleTypeLatLng    = "lat_lng"
+	gormTypesImportPath = "github.com/catalystcommunity/protoc-gen-go-gorm/gormtypes"
+)
+
+var googleTypeMap = map[protoreflect.FullName]string{
+	"google.type.Date":      googleTypeDate,
+	"google.type.TimeOfDay": googleTypeTimeOfDay,
+	"google.type.Money":     googleTypeMoney,
+	"google.type.Decimal":   googleTypeDecimal,
+	"google.type.LatLng":    googleTypeLatLng,
+}
+
+// ModelColumn is one of the columns of a field that's stored in more than one column
+type ModelColumn struct {
+	GoName    string
+	ModelType string
+	Tag       string
+}
+
+func (f *ModelField) parseGoogleType() (err error) {
+	if f.IsRepeated {
+		return fmt.Errorf("field %s: repeated %s fields are not supported, use the jsonb option instead", f.Desc.FullName(), f.Message.Desc.FullName())
+	}
+	f.GoogleType = googleTypeMap[f.Message.Desc.FullName()]
+	f.GoogleTypeGoIdent = g.QualifiedGoIdent(f.Message.GoIdent)
+	if f.Options.PostgisPoint {
+		if f.GoogleType != googleTypeLatLng || *engine != postgresEngine {
+			return fmt.Errorf("field %s: postgis_point is only supported on google.type.LatLng fields with the postgres engine", f.Desc.FullName())
+		}
+		f.PostgisPoint = true
+	}
+	switch f.GoogleType {
+	case googleTypeDate:
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "fmt"})
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/samber/lo"})
+	case googleTypeTimeOfDay:
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "fmt"})
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "time"})
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/samber/lo"})
+	case googleTypeMoney:
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: gormTypesImportPath})
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/samber/lo"})
+		f.Columns = []*ModelColumn{
+			f.googleTypeColumn("Amount", "*string", "type:numeric;"),
+			f.googleTypeColumn("Currency", "*string", ""),
+		}
+	case googleTypeDecimal:
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/samber/lo"})
+	case googleTypeLatLng:
+		if f.PostgisPoint {
+			g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: gormTypesImportPath})
+		} else {
+			g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/samber/lo"})
+			f.Columns = []*ModelColumn{
+				f.googleTypeColumn("Latitude", "*float64", ""),
+				f.googleTypeColumn("Longitude", "*float64", ""),
+			}
+		}
+	}
+	return
+}
+
+func (f *ModelField) googleTypeColumn(suffix, modelType, gormTag string) *ModelColumn {
+	tag := fmt.Sprintf(`json:"%s%s"`, f.Desc.JSONName(), suffix)
+	if gormTag != "" {
+		tag = fmt.Sprintf(`gorm:"%s" %s`, gormTag, tag)
+	}
+	return &ModelColumn{GoName: f.GoName + suffix, ModelType: modelType, Tag: "`" + tag + "`"}
+}
+
+func isGoogleType(field *protogen.Field) bool {
+	if field.Message == nil {
+		return false
+	}
+	_, ok := googleTypeMap[field.Message.Desc.FullName()]
+	return ok
+}
+
+func getGoogleTypeModelFieldType(field *ModelField) string {
+	switch field.GoogleType {
+	case googleTypeDate:
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "time"})
+		return "*time.Time"
+	case googleTypeLatLng:
+		if field.PostgisPoint {
+			return "*" + g.QualifiedGoIdent(protogen.GoIdent{GoName: "Point", GoImportPath: gormTypesImportPath})
+		}
+	}
+	// money and lat lng columns are rendered from the field's columns, the field type is only used for single columns
+	return "*string"
+}
+
+func getGoogleTypeColumnType(field *ModelField) string {
+	switch field.GoogleType {
+	case googleTypeDate:
+		return "date"
+	case googleTypeTimeOfDay:
+		return "time"
+	case googleTypeDecimal:
+		return "numeric"
+	case googleTypeLatLng:
+		return "geography(Point,4326)"
+	}
+	return ""
+}
@@ -2,54 +2,36 @@ package plugin
 
 import "text/template"
 
-var messageTemplate = template.Must(template.New("message").Funcs(templateFuncs).Parse(`
-type {{ .Model.Name }}s []*{{ .Model.Name }}
-type {{.GoIdent.GoName}}Protos []*{{.GoIdent.GoName}}
-type {{ .Model.Name }} struct {
-	{{- range .Model.Fields }}
+// fieldTemplates renders model struct fields and the field by field conversions between protos and models, they're
+// shared by the message and embedded templates
+var fieldTemplates = template.Must(template.New("fields").Funcs(templateFuncs).Parse(`
+{{ define "modelFields" }}
+	{{- range . }}
     {{ if .ShouldGenerateBelongsToIdField }}
     {{ .Options.GetBelongsTo.Foreignkey }} *string {{ emptyTag }}
     {{ end }}
     {{ .Comments -}}
+    {{ if .Columns -}}
+    {{ range $index, $column := .Columns }}{{ if $index }}
+    {{ end }}{{ $column.GoName }} {{ $column.ModelType }} {{ $column.Tag }}{{ end -}}
+    {{ else -}}
     {{ .GoName }} {{ .ModelType }} {{ .Tag -}}
+    {{ end -}}
+    {{ if .EnumAsLookup }}
+    {{ .GoName }}Lookup *{{ .EnumLookupModelName }} ` + "`" + `gorm:"foreignKey:{{ .GoName }};references:Id" json:"-"` + "`" + `
+    {{- end }}
 	{{ end }}
-}
+{{ end }}
 
-func (m *{{ .Model.Name }}) TableName() string {
-	return "{{ .Model.TableName }}"
-}
-
-func (m {{ .Model.Name }}s) ToProtos() (protos {{.GoIdent.GoName}}Protos, err error) {
-	protos = {{.GoIdent.GoName}}Protos{}
-	for _, model := range m {
-		var proto *{{.GoIdent.GoName}}
-		if proto, err = model.ToProto(); err != nil {
-			return
-		}
-		protos = append(protos, proto)
-	}
-	return
-}
-
-func (p {{.GoIdent.GoName}}Protos) ToModels() (models {{ .Model.Name }}s, err error) {
-	models = {{ .Model.Name }}s{}
-	for _, proto := range p {
-		var model *{{ .Model.Name }}
-		if model, err = proto.ToModel(); err != nil {
-			return
-		}
-		models = append(models, model)
-	}
-	return
-}
-
-func (m *{{ .Model.Name }}) ToProto() (theProto *{{.GoIdent.GoName}}, err error) {
-	if m == nil {
+{{ define "toProtoFields" }}
+	{{ range . }}
+    {{ if .IsCustomType }}
+	if theProto.{{ .GoName }}, err = {{ .CustomToProto }}(m.{{ .GoName }}); err != nil {
 		return
 	}
-	theProto = &{{.GoIdent.GoName}}{}
-	{{ range .Model.Fields }}
-    {{ if .IsTimestamp }}
+    {{ else if .GoogleType }}
+	{{- template "googleTypeToProto" . }}
+    {{ else if .IsTimestamp }}
     {{ if eq .Desc.Kind 9 }}
 	if m.{{ .GoName }} != nil {
 		theProto.{{ .GoName }} = m.{{ .GoName }}.Format(TimestampFormat)
@@ -59,13 +41,21 @@ func (m *{{ .Model.Name }}) ToProto() (theProto *{{.GoIdent.GoName}}, err error)
 		theProto.{{ .GoName }} = timestamppb.New(*m.{{ .GoName }})
 	}
     {{ end }}
+    {{ else if and (or .IsStructPb .IsJsonb) .IsRepeated }}
+	if len(m.{{ .GoName }}) > 0 {
+		theProto.{{ .GoName }} = []*{{ goIdent .Message.GoIdent }}{}
+		for _, value := range m.{{ .GoName }} {
+			item := &{{ goIdent .Message.GoIdent }}{}
+			if err = gormtypes.JSONBToMessage(value, item); err != nil {
+				return
+			}
+			theProto.{{ .GoName }} = append(theProto.{{ .GoName }}, item)
+		}
+	}
     {{ else if or .IsStructPb .IsJsonb }}
 	if m.{{ .GoName }} != nil {
-		var jsonBytes []byte
-		if jsonBytes, err = json.Marshal(m.{{ .GoName }}); err != nil {
-			return
-		}
-		if err = json.Unmarshal(jsonBytes, &theProto.{{ .GoName }}); err != nil {
+		theProto.{{ .GoName }} = &{{ goIdent .Message.GoIdent }}{}
+		if err = gormtypes.JSONBToMessage(m.{{ .GoName }}, theProto.{{ .GoName }}); err != nil {
 			return
 		}
 	}
@@ -77,15 +67,22 @@ func (m *{{ .Model.Name }}) ToProto() (theProto *{{.GoIdent.GoName}}, err error)
 		theProto.{{ .GoName }} = m.{{ .GoName }}.UTC().Format("{{ .TimeFormat }}")
 	{{- end }}
 	}
+    {{ else if .IsEmbedded }}
+	// an unset {{ .GoName }} is stored as zero columns, so zero columns are read back as unset
+	if !m.{{ .GoName }}.isZero() {
+		if theProto.{{ .GoName }}, err = m.{{ .GoName }}.ToProto(); err != nil {
+			return
+		}
+	}
     {{ else if and .IsMessage (eq .IsRepeated false) }}
 	if theProto.{{ .GoName }}, err = m.{{ .GoName }}.ToProto(); err != nil {
 		return
 	}
     {{ else if and .IsMessage .IsRepeated }}
 	if len(m.{{ .GoName }}) > 0 {
-		theProto.{{ .GoName }} = []*{{ .Message.Desc.Name }}{}
+		theProto.{{ .GoName }} = []*{{ goIdent .Message.GoIdent }}{}
         for _, item := range m.{{ .GoName }} {
-			var {{ .GoName }}Proto *{{ .Message.GoIdent.GoName }}
+			var {{ .GoName }}Proto *{{ goIdent .Message.GoIdent }}
 			if {{ .GoName }}Proto, err = item.ToProto(); err != nil {
 				return 
 			} else {
@@ -94,24 +91,42 @@ func (m *{{ .Model.Name }}) ToProto() (theProto *{{.GoIdent.GoName}}, err error)
 		}
 	}
     {{ else if and .Enum ( eq .IsRepeated false) }}
-	{{ if .Options.EnumAsString }}
-	theProto.{{ .GoName }} = {{ .Enum.GoIdent.GoName }}({{ .Enum.GoIdent.GoName }}_value[m.{{ .GoName }}])
+	{{ if .EnumAsString }}
+	if theProto.{{ .GoName }}, err = {{ .Enum.GoIdent.GoName }}FromStoredValue(m.{{ .GoName }}, {{ .StrictEnum }}); err != nil {
+		return
+	}
     {{ else }}
-	theProto.{{ .GoName }} = {{ .Enum.GoIdent.GoName }}(m.{{ .GoName }})
+	{{ if .StrictEnum -}}
+	if _, ok := {{ goIdent .Enum.GoIdent }}_name[int32(m.{{ .GoName }})]; !ok {
+		err = fmt.Errorf("unknown stored value %d for enum {{ .Enum.Desc.FullName }}", m.{{ .GoName }})
+		return
+	}
+	{{ end -}}
+	theProto.{{ .GoName }} = {{ goIdent .Enum.GoIdent }}(m.{{ .GoName }})
     {{ end }}
 	{{ else if and .Enum .IsRepeated }}
-	{{ if .Options.EnumAsString }}
+	{{ if .EnumAsString }}
 	if len(m.{{ .GoName }}) > 0 {
-		theProto.{{ .GoName }} = []{{ .Enum.GoIdent.GoName }}{}
+		theProto.{{ .GoName }} = []{{ goIdent .Enum.GoIdent }}{}
 		for _, val := range m.{{ .GoName }} {
-			theProto.{{ .GoName }} = append(theProto.{{ .GoName }}, {{ .Enum.GoIdent.GoName }}({{ .Enum.GoIdent.GoName }}_value[val]))
+			var enumValue {{ goIdent .Enum.GoIdent }}
+			if enumValue, err = {{ .Enum.GoIdent.GoName }}FromStoredValue(val, {{ .StrictEnum }}); err != nil {
+				return
+			}
+			theProto.{{ .GoName }} = append(theProto.{{ .GoName }}, enumValue)
 		}
 	}
     {{ else }}
 	if len(m.{{ .GoName }}) > 0 {
-		theProto.{{ .GoName }} = []{{ .Enum.GoIdent.GoName }}{}
+		theProto.{{ .GoName }} = []{{ goIdent .Enum.GoIdent }}{}
 		for _, val := range m.{{ .GoName }} {
-			theProto.{{ .GoName }} = append(theProto.{{ .GoName }}, {{ .Enum.GoIdent.GoName }}(val))
+			{{ if .StrictEnum -}}
+			if _, ok := {{ goIdent .Enum.GoIdent }}_name[val]; !ok {
+				err = fmt.Errorf("unknown stored value %d for enum {{ .Enum.Desc.FullName }}", val)
+				return
+			}
+			{{ end -}}
+			theProto.{{ .GoName }} = append(theProto.{{ .GoName }}, {{ goIdent .Enum.GoIdent }}(val))
 		}
 	}
     {{ end }}
@@ -119,39 +134,17 @@ func (m *{{ .Model.Name }}) ToProto() (theProto *{{.GoIdent.GoName}}, err error)
     theProto.{{ .GoName }} = m.{{ .GoName }}
     {{ end }}
 	{{ end }}
-	return
-}
-
-func (p *{{.GoIdent.GoName}}) GetProtoId() *string {
-	return p.Id
-}
-
-func (p *{{.GoIdent.GoName}}) SetProtoId(id string) {
-	p.Id = lo.ToPtr(id)
-}
-
-func (m *{{ .Model.Name }}) New() interface{} {
-	return &{{ .Model.Name }}{}
-}
-
-func (m *{{ .Model.Name }}) GetModelId() *string {
-	return m.Id
-}
-
-func (m *{{ .Model.Name }}) SetModelId(id string) {
-	if m == nil {
-	  m = &{{ .Model.Name }}{}
-	}
-	m.Id = lo.ToPtr(id)
-}
+{{ end }}
 
-func (p *{{.GoIdent.GoName}}) ToModel() (theModel *{{ .Model.Name }}, err error) {
-	if p == nil {
+{{ define "toModelFields" }}
+	{{ range . }}
+    {{ if .IsCustomType }}
+	if theModel.{{ .GoName }}, err = {{ .CustomToModel }}(p.{{ .GoName }}); err != nil {
 		return
 	}
-	theModel = &{{ .Model.Name }}{}
-	{{ range .Model.Fields }}
-    {{ if .IsTimestamp }}
+    {{ else if .GoogleType }}
+	{{- template "googleTypeToModel" . }}
+    {{ else if .IsTimestamp }}
 	{{ if eq .Desc.Kind 9 }}
 	if p.{{ .GoName }} != "" {
 		var timestamp time.Time
@@ -182,15 +175,30 @@ func (p *{{.GoIdent.GoName}}) ToModel() (theModel *{{ .Model.Name }}, err error)
 		dateUTC := date.UTC()
 		theModel.{{ .GoName }} = &dateUTC
 	}
+    {{ else if and (or .IsStructPb .IsJsonb) .IsRepeated }}
+	if len(p.{{ .GoName }}) > 0 {
+		theModel.{{ .GoName }} = {{ .ModelType }}{}
+		for _, item := range p.{{ .GoName }} {
+			var value {{ .ModelSingularType }}
+			if value, err = gormtypes.MessageToJSONB(item, {{ .JsonbMarshalOptions }}); err != nil {
+				return
+			}
+			theModel.{{ .GoName }} = append(theModel.{{ .GoName }}, value)
+		}
+	}
     {{ else if or .IsStructPb .IsJsonb }}
 	if p.{{ .GoName }} != nil {
-		var jsonBytes []byte
-		if jsonBytes, err = json.Marshal(p.{{ .GoName }}); err != nil {
+		if theModel.{{ .GoName }}, err = gormtypes.MessageToJSONB(p.{{ .GoName }}, {{ .JsonbMarshalOptions }}); err != nil {
 			return
 		}
-		if err = json.Unmarshal(jsonBytes, &theModel.{{ .GoName }}); err != nil {
+	}
+	{{ else if .IsEmbedded }}
+	if p.{{ .GoName }} != nil {
+		var {{ .GoName }}Embedded *{{ .ModelType }}
+		if {{ .GoName }}Embedded, err = p.{{ .GoName }}.ToGormEmbedded(); err != nil {
 			return
 		}
+		theModel.{{ .GoName }} = *{{ .GoName }}Embedded
 	}
 	{{ else if and .IsMessage (eq .IsRepeated false) }}
 	if theModel.{{ .GoName }}, err = p.{{ .GoName }}.ToModel(); err != nil {
@@ -219,17 +227,17 @@ func (p *{{.GoIdent.GoName}}) ToModel() (theModel *{{ .Model.Name }}, err error)
 		}
 	}
     {{ else if and .Enum (eq .IsRepeated false) }}
-	{{ if .Options.EnumAsString }}
-	theModel.{{ .GoName }} = p.{{ .GoName }}.String()
+	{{ if .EnumAsString }}
+	theModel.{{ .GoName }} = {{ .Enum.GoIdent.GoName }}ToStoredValue(p.{{ .GoName }})
 	{{ else }}
 	theModel.{{ .GoName }} = int(p.{{ .GoName }})
 	{{ end }}
 	{{ else if and .Enum .IsRepeated }}
-	{{ if .Options.EnumAsString }}
+	{{ if .EnumAsString }}
 	if len(p.{{ .GoName }}) > 0 {
 		theModel.{{ .GoName }} = pq.StringArray{}
 		for _, val := range p.{{ .GoName }} {
-			theModel.{{ .GoName }} = append(theModel.{{ .GoName }}, val.String())
+			theModel.{{ .GoName }} = append(theModel.{{ .GoName }}, {{ .Enum.GoIdent.GoName }}ToStoredValue(val))
 		}
 	}
     {{ else }}
@@ -244,10 +252,266 @@ func (p *{{.GoIdent.GoName}}) ToModel() (theModel *{{ .Model.Name }}, err error)
     theModel.{{ .GoName }} = p.{{ .GoName }}
     {{ end }}
 	{{ end }}
+{{ end }}
+` + googleTypeFieldTemplates))
+
+var messageTemplate = template.Must(template.Must(fieldTemplates.Clone()).New("message").Parse(`
+{{- define "conflictColumns" }}
+	{{- with .Model.ConflictTarget }}
+	Columns: []clause.Column{ {{- range $i, $column := . }}{{ if $i }}, {{ end }}{Name: "{{ $column }}"}{{ end }}},
+	{{- end }}
+{{- end }}
+{{- define "onConflict" }}
+	{{- template "conflictColumns" . }}
+	{{- if .Model.UpsertDoNothing }}
+	DoNothing: true,
+	{{- else }}
+	UpdateAll: true,
+	{{- end }}
+{{- end }}
+{{- define "upsertModels" }}
+		{{- if .Model.TenantField }}
+		// upsert in a transaction, so a batch holding rows of another tenant is rolled back as a whole
+		err = session.Transaction(func(session *gorm.DB) error {
+			rowsAffected, err := CreateInBatches(session.
+				{{- if .Model.UpsertDoNothing }}
+				// on conflict, leave rows of the same tenant as they are
+				{{- else }}
+				// on conflict, update all fields of rows of the same tenant
+				{{- end }}
+				Clauses(clause.OnConflict{
+					{{- if .Model.UpsertDoNothing }}
+					{{- template "conflictColumns" . }}
+					// do nothing can't be limited to the rows of the tenant, and doesn't count the rows it leaves as affected,
+					// so a conflict column is set to its own value instead, which leaves the row as it is but returns it
+					DoUpdates: clause.AssignmentColumns([]string{"{{ index .Model.ConflictTarget 0 }}"}),
+					{{- else }}
+					{{- template "onConflict" . }}
+					{{- end }}
+					Where: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "{{ .Model.TableName }}.{{ .Model.TenantColumn }} = excluded.{{ .Model.TenantColumn }}"}}},
+				}).
+				// exclude associations from upsert
+				Omit(clause.Associations), models)
+			// rows of another tenant aren't updated, so they aren't affected
+			if err == nil && rowsAffected < int64(len(models)) {
+				return gormtypes.ErrTenantMismatch
+			}
+			return err
+		})
+		{{- else }}
+		_, err = CreateInBatches(session.
+			{{- if .Model.UpsertDoNothing }}
+			// on conflict, leave the existing row as it is
+			{{- else }}
+            // on conflict, update all fields
+			{{- end }}
+			Clauses(clause.OnConflict{
+				{{- template "onConflict" . }}
+			}).
+            // exclude associations from upsert
+			Omit(clause.Associations), models)
+		{{- end }}
+{{- end }}
+{{- define "tenantSetting" }}
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
+	// run in a transaction setting the tenant of the table's row level security policy, see WithTenantSetting
+	err = WithTenantSetting[*{{ .Model.Name }}](ctx, tx, func(tx *gorm.DB) error {
+{{- end }}
+{{- define "upsertHistory" }}
+		{{- if .Model.History }}
+		err = record{{ .GoIdent.GoName }}History(ctx, session, ids, func(session *gorm.DB) (err error) {
+			{{- template "upsertOutbox" . }}
+			return
+		})
+		{{- else }}
+		{{- template "upsertOutbox" . }}
+		{{- end }}
+{{- end }}
+{{- define "upsertOutbox" }}
+		{{- if .Model.Outbox }}
+		err = record{{ .GoIdent.GoName }}Outbox(ctx, session, ids, func(session *gorm.DB) (err error) {
+			{{- template "upsertModels" . }}
+			return
+		})
+		{{- else }}
+		{{- template "upsertModels" . }}
+		{{- end }}
+{{- end }}
+type {{ .Model.Name }}s []*{{ .Model.Name }}
+type {{.GoIdent.GoName}}Protos []*{{.GoIdent.GoName}}
+type {{ .Model.Name }} struct {
+	{{- template "modelFields" .Model.Fields }}
+	{{- if .Model.HasSearchVector }}
+	// SearchVector is generated from the search fields, it's only read to be matched and ranked by Search{{ .Model.Name }}s
+	SearchVector string {{ .Model.SearchVectorTag }}
+	{{- end }}
+}
+
+func (m *{{ .Model.Name }}) TableName() string {
+	return "{{ .Model.TableName }}"
+}
+{{- if .Model.UpsertBatchSize }}
+
+// UpsertBatchSize caps the number of models upserts insert per statement, see CreateInBatches
+func (m *{{ .Model.Name }}) UpsertBatchSize() int {
+	return {{ .Model.UpsertBatchSize }}
+}
+{{- end }}
+{{- if .Model.HasConflictOptions }}
+
+// OnConflict is the on conflict clause of upserts of {{ .Model.Name }}s, see ConflictModel
+func (m *{{ .Model.Name }}) OnConflict() clause.OnConflict {
+	return clause.OnConflict{
+		{{- template "onConflict" . }}
+	}
+}
+{{- end }}
+{{ with .Model.CheckConstraints }}
+// {{ $.GoIdent.GoName }}CheckConstraintsDDL adds the CHECK constraints derived from {{ $.GoIdent.GoName }}'s validation rules to databases
+// that aren't migrated with AutoMigrate, which adds them from the model's check tags
+var {{ $.GoIdent.GoName }}CheckConstraintsDDL = []string{
+	{{- range . }}
+	{{ printf "%q" (.DDL $.Model.TableName) }},
+	{{- end }}
+}
+{{ end }}
+{{- if .Model.TenantField }}
+// TenantColumn returns the column holding the model's tenant
+func (m *{{ .Model.Name }}) TenantColumn() string {
+	return "{{ .Model.TenantColumn }}"
+}
+
+func (m *{{ .Model.Name }}) GetModelTenant() string {
+	return {{ .Model.TenantOf "m" }}
+}
+
+func (m *{{ .Model.Name }}) SetModelTenant(tenant string) {
+	{{ .Model.SetTenant "m" "tenant" }}
+}
+{{ end }}
+{{- if .Model.RowLevelSecurity }}
+// RowLevelSecurity is true as {{ .Model.TableName }} has a row level security policy, see RowLevelSecurityModel
+func (m *{{ .Model.Name }}) RowLevelSecurity() bool {
+	return true
+}
+{{ end }}
+{{- with .Model.SearchDDL }}
+// {{ $.GoIdent.GoName }}SearchDDL adds the generated search_vector column of {{ $.Model.TableName }} and its GIN index to databases that
+// aren't migrated with AutoMigrate, which adds them from the model's tags
+var {{ $.GoIdent.GoName }}SearchDDL = []string{
+	{{- range . }}
+	{{ printf "%q" . }},
+	{{- end }}
+}
+{{ end }}
+{{- with .Model.IndexesDDL }}
+// {{ $.GoIdent.GoName }}IndexesDDL creates the indexes declared by {{ $.GoIdent.GoName }}'s indexes option. AutoMigrate adds the indexes
+// of fields without a where clause from the model's tags, partial and expression indexes are only created by these
+var {{ $.GoIdent.GoName }}IndexesDDL = []string{
+	{{- range . }}
+	{{ printf "%q" . }},
+	{{- end }}
+}
+{{ end }}
+{{- with .Model.ViewDDL }}
+// {{ $.GoIdent.GoName }}ViewDDL creates or replaces the {{ $.Model.TableName }} view backing {{ $.GoIdent.GoName }}, to be run once the
+// tables it selects from are migrated. AutoMigrate mustn't be given its model, which would create a table instead
+var {{ $.GoIdent.GoName }}ViewDDL = []string{
+	{{- range . }}
+	{{ printf "%q" . }},
+	{{- end }}
+}
+{{ end }}
+{{- with .Model.RowLevelSecurityDDL }}
+// {{ $.GoIdent.GoName }}RowLevelSecurityDDL enables row level security on {{ $.Model.TableName }}, limiting rows to the tenant set by
+// gormtypes.SetTenantSetting in the transaction
+var {{ $.GoIdent.GoName }}RowLevelSecurityDDL = []string{
+	{{- range . }}
+	{{ printf "%q" . }},
+	{{- end }}
+}
+{{ end }}
+func (m {{ .Model.Name }}s) ToProtos() (protos {{.GoIdent.GoName}}Protos, err error) {
+	protos = {{.GoIdent.GoName}}Protos{}
+	for _, model := range m {
+		var proto *{{.GoIdent.GoName}}
+		if proto, err = model.ToProto(); err != nil {
+			return nil, &gormtypes.ConversionError{Err: err}
+		}
+		protos = append(protos, proto)
+	}
+	return
+}
+
+func (p {{.GoIdent.GoName}}Protos) ToModels() (models {{ .Model.Name }}s, err error) {
+	models = {{ .Model.Name }}s{}
+	for _, proto := range p {
+		var model *{{ .Model.Name }}
+		if model, err = proto.ToModel(); err != nil {
+			return nil, &gormtypes.ConversionError{Err: err}
+		}
+		models = append(models, model)
+	}
+	return
+}
+
+func (m *{{ .Model.Name }}) ToProto() (theProto *{{.GoIdent.GoName}}, err error) {
+	if m == nil {
+		return
+	}
+	theProto = &{{.GoIdent.GoName}}{}
+	{{ template "toProtoFields" .Model.Fields }}
+	return
+}
+
+func (p *{{.GoIdent.GoName}}) GetProtoId() *string {
+	return p.Id
+}
+
+func (p *{{.GoIdent.GoName}}) SetProtoId(id string) {
+	p.Id = lo.ToPtr(id)
+}
+
+func (m *{{ .Model.Name }}) New() interface{} {
+	return &{{ .Model.Name }}{}
+}
+
+func (m *{{ .Model.Name }}) GetModelId() *string {
+	return m.Id
+}
+
+func (m *{{ .Model.Name }}) SetModelId(id string) {
+	if m == nil {
+	  m = &{{ .Model.Name }}{}
+	}
+	m.Id = lo.ToPtr(id)
+}
+
+func (p *{{.GoIdent.GoName}}) ToModel() (theModel *{{ .Model.Name }}, err error) {
+	if p == nil {
+		return
+	}
+	theModel = &{{ .Model.Name }}{}
+	{{ template "toModelFields" .Model.Fields }}
+	return
+}
+
+{{ if .Model.RowLevelSecurity -}}
+func (m {{ .Model.Name }}s) GetByModelIds(ctx context.Context, tx *gorm.DB, preloads ...string) (err error) {
+	{{- template "tenantSetting" . }}
+		return m.getByModelIds(ctx, tx, preloads...)
+	})
 	return
 }
 
+// getByModelIds loads the models by their ids, see GetByModelIds
+func (m {{ .Model.Name }}s) getByModelIds(ctx context.Context, tx *gorm.DB, preloads ...string) (err error) {
+{{- else -}}
 func (m {{ .Model.Name }}s) GetByModelIds(ctx context.Context, tx *gorm.DB, preloads ...string) (err error) {
+{{- end }}
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	ids := []string{}
 	for _, model := range m {
 		if model.Id != nil {
@@ -259,19 +523,55 @@ func (m {{ .Model.Name }}s) GetByModelIds(ctx context.Context, tx *gorm.DB, prel
 		for _, preload := range preloads {
 			statement = statement.Preload(preload)
 		}
-		err = statement.Where("id in ?", ids).Find(&m).Error
+		statement = statement.Where("id in ?", ids)
+		{{- if .Model.TenantField }}
+		if statement, err = ScopeTenant[*{{ .Model.Name }}](ctx, statement); err != nil {
+			return
+		}
+		{{- end }}
+		err = statement.Find(&m).Error
 	}
 	return
 }
 
+{{- if not .Model.View }}
+
 // Upsert creates the protos using an on conflict clause to do updates. This function does not update *any* associations
 // use gorm's association mode functions to update associations as you see fit after calling upsert. See https://gorm.io/docs/associations.html#Replace-Associations
 func (p *{{.GoIdent.GoName}}Protos) Upsert(ctx context.Context, tx *gorm.DB) (models {{ .Model.Name }}s, err error) {
+{{- if .Model.RowLevelSecurity }}
+	{{- template "tenantSetting" . }}
+		models, err = p.upsert(ctx, tx)
+		return err
+	})
+	return
+}
+
+// upsert creates or updates the protos, see Upsert
+func (p *{{.GoIdent.GoName}}Protos) upsert(ctx context.Context, tx *gorm.DB) (models {{ .Model.Name }}s, err error) {
+{{- end }}
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
+		{{- if .Model.TenantField }}
+		tenant, ok := gormtypes.TenantFromContext(ctx)
+		if !ok {
+			return nil, gormtypes.ErrMissingTenant
+		}
+		{{- end }}
 		for _, proto := range *p {
 			if proto.Id == nil {
 				proto.Id = lo.ToPtr(uuid.New().String())
 			}
+			{{- if .Model.TenantField }}
+			switch {{ .Model.TenantOf "proto" }} {
+			case "":
+				{{ .Model.SetTenant "proto" "tenant" }}
+			case tenant:
+			default:
+				return nil, gormtypes.ErrTenantMismatch
+			}
+			{{- end }}
 		}
 		models, err = p.ToModels()
 		if err != nil {
@@ -279,19 +579,47 @@ func (p *{{.GoIdent.GoName}}Protos) Upsert(ctx context.Context, tx *gorm.DB) (mo
 		}
         // create new session so the tx isn't modified
 		session := tx.Session(&gorm.Session{})
-		err = session.
-            // on conflict, update all fields
-			Clauses(clause.OnConflict{
-				UpdateAll: true,
-			}).
-            // exclude associations from upsert
-			Omit(clause.Associations).
-			Create(&models).Error
+		{{- if .Model.RecordsConflictingRows }}
+		err = session.Transaction(func(session *gorm.DB) (err error) {
+			// the rows the models conflict with are updated in their place, so their ids are recorded too
+			ids, err := UpsertIds(session, models)
+			if err != nil {
+				return
+			}
+			{{- template "upsertHistory" . }}
+			return
+		})
+		{{- else }}
+		{{- if or .Model.History .Model.Outbox }}
+		ids := []string{}
+		for _, model := range models {
+			ids = append(ids, *model.Id)
+		}
+		{{- end }}
+		{{- template "upsertHistory" . }}
+		{{- end }}
+		if err == nil {
+			// update the protos with the models, which are the rows as stored
+			err = RefreshProtos(*p, models)
+		}
 	}
 	return
 }
+{{- end }}
 
 func (p *{{.GoIdent.GoName}}Protos) List(ctx context.Context, tx *gorm.DB, limit, offset int, order interface{}, preloads ...string) (err error) {
+{{- if .Model.RowLevelSecurity }}
+	{{- template "tenantSetting" . }}
+		return p.list(ctx, tx, limit, offset, order, preloads...)
+	})
+	return
+}
+
+// list lists the protos, see List
+func (p *{{.GoIdent.GoName}}Protos) list(ctx context.Context, tx *gorm.DB, limit, offset int, order interface{}, preloads ...string) (err error) {
+{{- end }}
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models {{ .Model.Name }}s
 		statement := tx.Preload(clause.Associations).Limit(limit).Offset(offset)
@@ -301,6 +629,11 @@ func (p *{{.GoIdent.GoName}}Protos) List(ctx context.Context, tx *gorm.DB, limit
 		if order != nil {
 			statement = statement.Order(order)
 		}
+		{{- if .Model.TenantField }}
+		if statement, err = ScopeTenant[*{{ .Model.Name }}](ctx, statement); err != nil {
+			return
+		}
+		{{- end }}
 		if err = statement.Find(&models).Error; err != nil {
 		  return
 		}
@@ -314,13 +647,31 @@ func (p *{{.GoIdent.GoName}}Protos) List(ctx context.Context, tx *gorm.DB, limit
 }
 
 func (p *{{.GoIdent.GoName}}Protos) GetByIds(ctx context.Context, tx *gorm.DB, ids []string, preloads ...string) (err error) {
+{{- if .Model.RowLevelSecurity }}
+	{{- template "tenantSetting" . }}
+		return p.getByIds(ctx, tx, ids, preloads...)
+	})
+	return
+}
+
+// getByIds gets the protos by id, see GetByIds
+func (p *{{.GoIdent.GoName}}Protos) getByIds(ctx context.Context, tx *gorm.DB, ids []string, preloads ...string) (err error) {
+{{- end }}
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
 	if p != nil {
 		var models {{ .Model.Name }}s
 		statement := tx.Preload(clause.Associations)
 		for _, preload := range preloads {
 		  statement = statement.Preload(preload)
 		}
-		if err = statement.Where("id in ?", ids).Find(&models).Error; err != nil {
+		statement = statement.Where("id in ?", ids)
+		{{- if .Model.TenantField }}
+		if statement, err = ScopeTenant[*{{ .Model.Name }}](ctx, statement); err != nil {
+			return
+		}
+		{{- end }}
+		if err = statement.Find(&models).Error; err != nil {
 		  return
 		}
 		if len(models) > 0 {
@@ -332,8 +683,140 @@ func (p *{{.GoIdent.GoName}}Protos) GetByIds(ctx context.Context, tx *gorm.DB, i
 	return
 }
 
-func Delete{{ .Model.Name }}s(ctx context.Context, tx *gorm.DB, ids []string) error {
-    statement := tx.Where("id in ?", ids)
-	return statement.Delete(&{{ .Model.Name }}{}).Error	
+{{ if .Model.SearchFields -}}
+// Search{{ .Model.Name }}s returns up to limit {{ .GoIdent.GoName }}s matching the full text search query, best ranked first
+{{- if .Model.HasSearchVector }}. The
+// query is parsed with websearch_to_tsquery, so it may quote phrases and use or and - operators
+{{- else }}. The
+// search fields are matched with ILIKE, and ranked by the highest weight of the fields containing the query
+{{- end }}
+func Search{{ .Model.Name }}s(ctx context.Context, tx *gorm.DB, query string, limit int) (protos {{ .GoIdent.GoName }}Protos, err error) {
+{{- if .Model.RowLevelSecurity }}
+	{{- template "tenantSetting" . }}
+		protos, err = search{{ .Model.Name }}s(ctx, tx, query, limit)
+		return err
+	})
+	return
+}
+
+// search{{ .Model.Name }}s searches the {{ .GoIdent.GoName }}s, see Search{{ .Model.Name }}s
+func search{{ .Model.Name }}s(ctx context.Context, tx *gorm.DB, query string, limit int) (protos {{ .GoIdent.GoName }}Protos, err error) {
+{{- end }}
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
+	{{- if .Model.HasSearchVector }}
+	tsquery := clause.Expr{SQL: "websearch_to_tsquery(?, ?)", Vars: []interface{}{"{{ .Model.SearchLanguage }}", query}}
+	statement := tx.Preload(clause.Associations).Where("search_vector @@ ?", tsquery).Clauses(clause.OrderBy{
+		Expression: clause.Expr{SQL: "ts_rank(search_vector, ?) DESC, id", Vars: []interface{}{tsquery}},
+	}).Limit(limit)
+	{{- else }}
+	pattern := map[string]interface{}{"pattern": "%" + gormtypes.EscapeLike(query) + "%"}
+	statement := tx.Preload(clause.Associations).Where("{{ .Model.SearchMatch }}", pattern).Clauses(clause.OrderBy{
+		Expression: clause.NamedExpr{SQL: "{{ .Model.SearchRank }}, id", Vars: []interface{}{pattern}},
+	}).Limit(limit)
+	{{- end }}
+	{{- if .Model.TenantField }}
+	if statement, err = ScopeTenant[*{{ .Model.Name }}](ctx, statement); err != nil {
+		return
+	}
+	{{- end }}
+	models := {{ .Model.Name }}s{}
+	if err = statement.Find(&models).Error; err != nil {
+		return
+	}
+	return models.ToProtos()
+}
+
+{{ end -}}
+{{ if not .Model.View -}}
+// Delete{{ .Model.Name }}s deletes the models with the given ids, returning the deleted models and protos
+func Delete{{ .Model.Name }}s(ctx context.Context, tx *gorm.DB, ids []string) (models {{ .Model.Name }}s, protos {{ .GoIdent.GoName }}Protos, err error) {
+{{- if .Model.RowLevelSecurity }}
+	{{- template "tenantSetting" . }}
+		models, protos, err = delete{{ .Model.Name }}s(ctx, tx, ids)
+		return err
+	})
+	return
+}
+
+// delete{{ .Model.Name }}s deletes the models with the given ids, see Delete{{ .Model.Name }}s
+func delete{{ .Model.Name }}s(ctx context.Context, tx *gorm.DB, ids []string) (models {{ .Model.Name }}s, protos {{ .GoIdent.GoName }}Protos, err error) {
+{{- end }}
+	// classify the errors of gorm and the driver, see gormtypes.ClassifyError
+	defer func() { err = gormtypes.ClassifyError(err) }()
+	remove := func(tx *gorm.DB) (err error) {
+		statement := tx.Where("id in ?", ids)
+		{{- if .Model.TenantField }}
+		if statement, err = ScopeTenant[*{{ .Model.Name }}](ctx, statement); err != nil {
+			return
+		}
+		{{- end }}
+		models, err = DeleteReturning[*{{ .Model.Name }}](statement)
+		return
+	}
+	{{- if .Model.Outbox }}
+	write := remove
+	remove = func(tx *gorm.DB) error {
+		return record{{ .GoIdent.GoName }}Outbox(ctx, tx, ids, write)
+	}
+	{{- end }}
+	{{- if .Model.History }}
+	if err = record{{ .GoIdent.GoName }}History(ctx, tx, ids, remove); err != nil {
+	{{- else }}
+	if err = remove(tx); err != nil {
+	{{- end }}
+		return
+	}
+	protos, err = models.ToProtos()
+	return
+}
+{{- end }}
+{{- if or .Model.History .Model.Outbox }}
+
+// find{{ .GoIdent.GoName }}Models returns the models with the given ids, without their associations, by id
+func find{{ .GoIdent.GoName }}Models(ctx context.Context, tx *gorm.DB, ids []string) (map[string]*{{ .Model.Name }}, error) {
+	statement := tx.Where("id in ?", ids)
+	{{- if .Model.TenantField }}
+	statement, err := ScopeTenant[*{{ .Model.Name }}](ctx, statement)
+	if err != nil {
+		return nil, err
+	}
+	{{- end }}
+	models := {{ .Model.Name }}s{}
+	if err := statement.Find(&models).Error; err != nil {
+		return nil, err
+	}
+	return lo.KeyBy(models, func(model *{{ .Model.Name }}) string { return *model.Id }), nil
+}
+{{- end }}
+`))
+
+var embeddedTemplate = template.Must(template.Must(fieldTemplates.Clone()).New("embedded").Parse(`
+// {{ .Name }} holds the columns of a {{ .GoIdent.GoName }} embedded into the table of the model referencing it
+type {{ .Name }} struct {
+	{{- template "modelFields" .Fields }}
+}
+
+// isZero is true when every column of the {{ .GoIdent.GoName }} is zero, as they are when it's unset
+func (m *{{ .Name }}) isZero() bool {
+	return reflect.ValueOf(*m).IsZero()
+}
+
+func (m *{{ .Name }}) ToProto() (theProto *{{ .GoIdent.GoName }}, err error) {
+	if m == nil {
+		return
+	}
+	theProto = &{{ .GoIdent.GoName }}{}
+	{{ template "toProtoFields" .Fields }}
+	return
+}
+
+func (p *{{ .GoIdent.GoName }}) ToGormEmbedded() (theModel *{{ .Name }}, err error) {
+	if p == nil {
+		return
+	}
+	theModel = &{{ .Name }}{}
+	{{ template "toModelFields" .Fields }}
+	return
 }
 `))
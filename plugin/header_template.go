@@ -6,6 +6,17 @@ var headerTemplate = template.Must(template.New("header").Parse(`
 // Code generated by protoc-gen-go-gorm. DO NOT EDIT.
 // source: {{ .Proto.Name }}
 
+package {{ .GoPackageName }}
+`))
+
+// packageHeaderTemplate starts the package file, which holds the declarations shared by every file in the package
+var packageHeaderTemplate = template.Must(template.New("package").Parse(`
+// Code generated by protoc-gen-go-gorm. DO NOT EDIT.
+// sources:
+{{- range .Files }}
+// {{ .Proto.Name }}
+{{- end }}
+
 package {{ .GoPackageName }}
 
 // cockroachdb doesn't support nanosecond timestamp columns so use microsecond instead
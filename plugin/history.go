@@ -0,0 +1,45 @@
+package plugin
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// parseHistory enables the history of messages with the history option
+func (m *Model) parseHistory() {
+	if !getMessageOptions(m.Message).GetHistory() {
+		return
+	}
+	m.History = true
+	for _, importPath := range []protogen.GoImportPath{"bytes", "encoding/json", "time", gormTypesImportPath,
+		"github.com/samber/lo", "google.golang.org/protobuf/encoding/protojson"} {
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: importPath})
+	}
+}
+
+// HistoryModelName is the name of the model of the message's history rows
+func (m *Model) HistoryModelName() string {
+	return fmt.Sprintf("%sHistoryGormModel", m.Message.GoIdent.GoName)
+}
+
+// HistoryTableName is the table of the message's history rows
+func (m *Model) HistoryTableName() string {
+	return fmt.Sprintf("%s_history", m.TableName)
+}
+
+// HistoryIdTag is the struct tag of the history rows' ids
+func (m *Model) HistoryIdTag() string {
+	return fmt.Sprintf("`gorm:\"%s\" json:\"id\"`", getIdTag())
+}
+
+// hasHistory is true when one of the messages has history, the generic upsert and delete then record the changes of
+// models with history
+func hasHistory(messages []*PreparedMessage) bool {
+	for _, message := range messages {
+		if message.Model.History {
+			return true
+		}
+	}
+	return false
+}
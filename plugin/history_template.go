@@ -0,0 +1,147 @@
+package plugin
+
+import "text/template"
+
+// historyTemplate renders the history model of a message with the history option, and the functions recording and
+// reading its changes
+var historyTemplate = template.Must(template.New("history").Funcs(templateFuncs).Parse(`
+{{- $message := .GoIdent.GoName }}
+// {{ .Model.HistoryModelName }} records a change to one of the {{ $message }}s, with its protojson before and after the
+// change. Before is null for creates and After for deletes
+type {{ .Model.HistoryModelName }} struct {
+	Id *string {{ .Model.HistoryIdTag }}
+	RecordId string ` + "`" + `gorm:"type:uuid;index" json:"recordId"` + "`" + `
+	{{- if .Model.TenantField }}
+	{{ .Model.TenantField.GoName }} string ` + "`" + `gorm:"index" json:"{{ .Model.TenantField.Desc.JSONName }}"` + "`" + `
+	{{- end }}
+	Operation string ` + "`" + `json:"operation"` + "`" + `
+	Actor string ` + "`" + `json:"actor"` + "`" + `
+	ChangedAt *time.Time ` + "`" + `gorm:"type:timestamp;default:clock_timestamp()" json:"changedAt"` + "`" + `
+	Before json.RawMessage ` + "`" + `gorm:"type:jsonb" json:"before"` + "`" + `
+	After json.RawMessage ` + "`" + `gorm:"type:jsonb" json:"after"` + "`" + `
+}
+
+func (m *{{ .Model.HistoryModelName }}) TableName() string {
+	return "{{ .Model.HistoryTableName }}"
+}
+
+// {{ $message }}HistoryEntry is a change to one of the {{ $message }}s, Before is nil for creates and After for deletes
+type {{ $message }}HistoryEntry struct {
+	Operation string
+	Actor     string
+	ChangedAt time.Time
+	Before    *{{ $message }}
+	After     *{{ $message }}
+}
+
+// RecordHistory runs the write in a transaction, and records a history row for each of the rows with the given ids
+// the write changed, see record{{ $message }}History
+func (m *{{ .Model.Name }}) RecordHistory(ctx context.Context, tx *gorm.DB, ids []string, write func(tx *gorm.DB) error) error {
+	return record{{ $message }}History(ctx, tx, ids, write)
+}
+
+// record{{ $message }}History runs the write in a transaction, and records a history row for each of the rows with the
+// given ids the write changed. The rows are locked before the write, and the actor is read from the context, see
+// gormtypes.WithActor
+func record{{ $message }}History(ctx context.Context, tx *gorm.DB, ids []string, write func(tx *gorm.DB) error) error {
+	return tx.Transaction(func(tx *gorm.DB) error {
+		before, err := snapshot{{ $message }}s(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), ids)
+		if err != nil {
+			return err
+		}
+		if err = write(tx); err != nil {
+			return err
+		}
+		after, err := snapshot{{ $message }}s(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		{{- if .Model.TenantField }}
+		tenant, _ := gormtypes.TenantFromContext(ctx)
+		{{- end }}
+		rows := []*{{ .Model.HistoryModelName }}{}
+		for _, id := range lo.Uniq(ids) {
+			row := &{{ .Model.HistoryModelName }}{
+				RecordId:  id,
+				{{- if .Model.TenantField }}
+				{{ .Model.TenantField.GoName }}: tenant,
+				{{- end }}
+				Actor:     gormtypes.ActorFromContext(ctx),
+				Before:    before[id],
+				After:     after[id],
+			}
+			switch {
+			case bytes.Equal(row.Before, row.After):
+				// the write didn't change the row, or there is no such row
+				continue
+			case row.Before == nil:
+				row.Operation = gormtypes.HistoryCreate
+			case row.After == nil:
+				row.Operation = gormtypes.HistoryDelete
+			default:
+				row.Operation = gormtypes.HistoryUpdate
+			}
+			rows = append(rows, row)
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// snapshot{{ $message }}s returns the protojson of the rows with the given ids, without their associations, by id
+func snapshot{{ $message }}s(ctx context.Context, tx *gorm.DB, ids []string) (map[string]json.RawMessage, error) {
+	models, err := find{{ $message }}Models(ctx, tx, ids)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := map[string]json.RawMessage{}
+	for id, model := range models {
+		theProto, err := model.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		if snapshots[id], err = protojson.Marshal(theProto); err != nil {
+			return nil, err
+		}
+	}
+	return snapshots, nil
+}
+
+// {{ $message }}History returns the changes of the {{ $message }} with the given id, oldest first
+func {{ $message }}History(ctx context.Context, tx *gorm.DB, id string) ([]*{{ $message }}HistoryEntry, error) {
+	statement := tx.Where("record_id = ?", id)
+	{{- if .Model.TenantField }}
+	tenant, ok := gormtypes.TenantFromContext(ctx)
+	if !ok {
+		return nil, gormtypes.ErrMissingTenant
+	}
+	statement = statement.Where("{{ .Model.TenantColumn }} = ?", tenant)
+	{{- end }}
+	rows := []*{{ .Model.HistoryModelName }}{}
+	if err := statement.Order("changed_at, id").Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	// snapshots of an older version of the message may have fields it doesn't have anymore
+	unmarshal := protojson.UnmarshalOptions{DiscardUnknown: true}
+	entries := []*{{ $message }}HistoryEntry{}
+	for _, row := range rows {
+		entry := &{{ $message }}HistoryEntry{Operation: row.Operation, Actor: row.Actor, ChangedAt: lo.FromPtr(row.ChangedAt)}
+		if row.Before != nil {
+			entry.Before = &{{ $message }}{}
+			if err := unmarshal.Unmarshal(row.Before, entry.Before); err != nil {
+				return nil, err
+			}
+		}
+		if row.After != nil {
+			entry.After = &{{ $message }}{}
+			if err := unmarshal.Unmarshal(row.After, entry.After); err != nil {
+				return nil, err
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+`))
@@ -0,0 +1,128 @@
+package plugin
+
+import (
+	"fmt"
+	"strings"
+
+	gorm "github.com/catalystcommunity/protoc-gen-go-gorm/options"
+	"github.com/samber/lo"
+	"github.com/stoewer/go-strcase"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// indexTypes are the index methods each engine supports
+var indexTypes = map[string][]string{
+	postgresEngine:    {"btree", "hash", "gin", "gist", "spgist", "brin"},
+	cockroachdbEngine: {"btree", "gin"},
+}
+
+// Index is an index declared by a message's indexes option
+type Index struct {
+	Name       string
+	Columns    []string
+	Expression string
+	Unique     bool
+	Where      string
+	Type       string
+}
+
+// parseIndexes resolves the message's indexes option to the indexes of the model's table
+func (m *Model) parseIndexes() error {
+	for _, options := range getMessageOptions(m.Message).GetIndexes() {
+		index := &Index{Name: options.Name, Expression: options.Expression, Unique: options.Unique, Where: options.Where,
+			Type: strings.ToLower(options.Type)}
+		if (len(options.Fields) == 0) == (options.Expression == "") {
+			return fmt.Errorf("message %s: indexes must have either fields or an expression", m.Desc.FullName())
+		}
+		if options.Expression != "" && options.Name == "" {
+			return fmt.Errorf("message %s: expression indexes require a name", m.Desc.FullName())
+		}
+		if index.Type != "" && !lo.Contains(indexTypes[*engine], index.Type) {
+			return fmt.Errorf("message %s: unsupported index type %s, supported types are %s", m.Desc.FullName(), options.Type,
+				strings.Join(indexTypes[*engine], ", "))
+		}
+		for _, name := range options.Fields {
+			field, ok := lo.Find(m.Fields, func(field *ModelField) bool { return string(field.Desc.Name()) == name })
+			if !ok {
+				return fmt.Errorf("message %s: index field %s is not a field of the message", m.Desc.FullName(), name)
+			}
+			if field.IsAssociation() || field.IsEmbedded || len(field.Columns) > 0 {
+				return fmt.Errorf("message %s: index field %s must be a field stored in a single column", m.Desc.FullName(), name)
+			}
+			index.Columns = append(index.Columns, getColumnName(field.GoName))
+		}
+		index.Name = getIndexName(m.Message, options)
+		m.Indexes = append(m.Indexes, index)
+	}
+	return nil
+}
+
+// IndexesDDL returns the statements creating the model's indexes, which can be run again
+func (m *Model) IndexesDDL() []string {
+	statements := make([]string, len(m.Indexes))
+	for i, index := range m.Indexes {
+		statements[i] = index.DDL(m.TableName)
+	}
+	return statements
+}
+
+// DDL returns the statement creating the index on the table
+func (i *Index) DDL(table string) string {
+	statement := "CREATE "
+	if i.Unique {
+		statement += "UNIQUE "
+	}
+	statement += fmt.Sprintf("INDEX IF NOT EXISTS %s ON %s", i.Name, table)
+	if i.Type != "" && i.Type != "btree" {
+		statement += " USING " + strings.ToUpper(i.Type)
+	}
+	if i.Expression != "" {
+		statement += fmt.Sprintf(" ((%s))", i.Expression)
+	} else {
+		statement += fmt.Sprintf(" (%s)", strings.Join(i.Columns, ", "))
+	}
+	if i.Where != "" {
+		statement += " WHERE " + i.Where
+	}
+	return statement
+}
+
+// getIndexTag returns the index tags of a field of its message's indexes of fields without a where clause, so
+// AutoMigrate creates them. Indexes with a where clause are left to the DDL statements, as gorm's tags can't hold
+// every expression
+func getIndexTag(field *ModelField) string {
+	if field.Embedded {
+		return ""
+	}
+	tag := ""
+	for _, options := range getMessageOptions(field.Parent).GetIndexes() {
+		position := lo.IndexOf(options.Fields, string(field.Desc.Name()))
+		if position < 0 || options.Where != "" {
+			continue
+		}
+		tag += fmt.Sprintf("index:%s,priority:%d", getIndexName(field.Parent, options), position+1)
+		if options.Unique {
+			tag += ",unique"
+		}
+		if options.Type != "" {
+			tag += ",type:" + strings.ToLower(options.Type)
+		}
+		tag += ";"
+	}
+	return tag
+}
+
+// getIndexName returns the name of an index of the message's table, idx_<table>_<columns> unless it's named
+func getIndexName(message *protogen.Message, options *gorm.IndexOptions) string {
+	if options.Name != "" {
+		return options.Name
+	}
+	columns := make([]string, len(options.Fields))
+	for i, name := range options.Fields {
+		columns[i] = strcase.SnakeCase(name)
+		if field, ok := lo.Find(message.Fields, func(field *protogen.Field) bool { return string(field.Desc.Name()) == name }); ok {
+			columns[i] = getColumnName(field.GoName)
+		}
+	}
+	return fmt.Sprintf("idx_%s_%s", getTableNameFromMessage(message), strings.Join(columns, "_"))
+}
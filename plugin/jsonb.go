@@ -0,0 +1,35 @@
+package plugin
+
+import (
+	"strings"
+
+	gorm "github.com/catalystcommunity/protoc-gen-go-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// getJsonbMarshalOptions returns the protojson.MarshalOptions literal a jsonb or google.protobuf.Struct field is stored
+// with, from the field's jsonb_options or else the file's
+func getJsonbMarshalOptions(field *ModelField) string {
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "google.golang.org/protobuf/encoding/protojson"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: gormTypesImportPath})
+	options := field.Options.JsonbOptions
+	if options == nil {
+		if fileOptions := getFileOptions(field.Parent.Desc.ParentFile()); fileOptions != nil {
+			options = fileOptions.JsonbOptions
+		}
+	}
+	if options == nil {
+		options = &gorm.JsonbOptions{}
+	}
+	var fields []string
+	if options.UseProtoNames {
+		fields = append(fields, "UseProtoNames: true")
+	}
+	if options.UseEnumNumbers {
+		fields = append(fields, "UseEnumNumbers: true")
+	}
+	if options.EmitUnpopulated {
+		fields = append(fields, "EmitUnpopulated: true")
+	}
+	return "protojson.MarshalOptions{" + strings.Join(fields, ", ") + "}"
+}
@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"fmt"
+
 	"google.golang.org/protobuf/compiler/protogen"
 )
 
@@ -10,6 +12,39 @@ type Model struct {
 	TableName               string
 	Fields                  []*ModelField
 	HasReplaceRelationships bool
+	// ManyToManyFields are the many to many fields the generic association functions can be used with, which need both
+	// models to be in the same go package
+	ManyToManyFields []*ModelField
+	// TenantField holds the tenant of the model's rows when the message has a tenant_column
+	TenantField      *ModelField
+	TenantColumn     string
+	RowLevelSecurity bool
+	// History is true when the model's changes are recorded in a history table
+	History bool
+	// Outbox is true when the model's changes insert outbox events
+	Outbox bool
+	// UpsertBatchSize caps the number of models upserts insert per statement when it's positive
+	UpsertBatchSize int
+	// ConflictColumns are the columns upserts conflict on instead of the id
+	ConflictColumns []string
+	// UpsertDoNothing is true when upserts leave existing rows as they are
+	UpsertDoNothing bool
+	// SearchFields are the columns of the model's full text search, by weight from highest to lowest
+	SearchFields []*SearchField
+	// SearchLanguage is the text search configuration of the search fields
+	SearchLanguage string
+	// Indexes are the indexes of the message's indexes option
+	Indexes []*Index
+	// View is true when the message is backed by a view, its model is then read only
+	View bool
+	// ViewDefinition is the query of the view, when the view is created from the message's options
+	ViewDefinition string
+}
+
+// OrderColumn is a column of a model's table rows can be ordered by
+type OrderColumn struct {
+	Name   string
+	GoName string
 }
 
 func (m *Model) Parse() (err error) {
@@ -28,6 +63,47 @@ func (m *Model) Parse() (err error) {
 			m.HasReplaceRelationships = true
 		}
 		m.Fields = append(m.Fields, modelField)
+		if modelField.Options.GetManyToMany() != nil && modelField.Message.GoIdent.GoImportPath == m.Message.GoIdent.GoImportPath {
+			m.ManyToManyFields = append(m.ManyToManyFields, modelField)
+		}
+	}
+	if m.UpsertBatchSize = int(getMessageOptions(m.Message).GetUpsertBatchSize()); m.UpsertBatchSize < 0 {
+		return fmt.Errorf("message %s: upsert_batch_size must not be negative", m.Desc.FullName())
+	}
+	if err = m.parseUpsert(); err != nil {
+		return
+	}
+	if err = m.parseSearch(); err != nil {
+		return
+	}
+	if err = m.parseIndexes(); err != nil {
+		return
+	}
+	if err = m.parseView(); err != nil {
+		return
+	}
+	m.parseHistory()
+	m.parseOutbox()
+	return m.parseTenant()
+}
+
+// OrderColumns returns the columns holding a single scalar value, which rows can be ordered by
+func (m *Model) OrderColumns() (columns []*OrderColumn) {
+	for _, field := range m.Fields {
+		if field.ShouldGenerateBelongsToIdField {
+			foreignKey := field.Options.GetBelongsTo().Foreignkey
+			columns = append(columns, &OrderColumn{Name: getColumnName(foreignKey), GoName: foreignKey})
+		}
+		if field.IsAssociation() || field.IsEmbedded || field.IsJsonb || field.IsStructPb || field.IsRepeated {
+			continue
+		}
+		if len(field.Columns) > 0 {
+			for _, column := range field.Columns {
+				columns = append(columns, &OrderColumn{Name: getColumnName(column.GoName), GoName: column.GoName})
+			}
+			continue
+		}
+		columns = append(columns, &OrderColumn{Name: getColumnName(field.GoName), GoName: field.GoName})
 	}
 	return
 }
@@ -1,6 +1,7 @@
 package plugin
 
 import (
+	"fmt"
 	"strings"
 
 	gorm "github.com/catalystcommunity/protoc-gen-go-gorm/options"
@@ -25,6 +26,22 @@ type ModelField struct {
 	ShouldGenerateBelongsToIdField bool
 	HasReplaceRelationships        bool // any relationships except belongs to needs replace calls
 	TimeFormat                     string
+	EnumStrategy                   gorm.EnumStrategy
+	EnumAsString                   bool // string and native strategies store the enum's stored value
+	EnumAsLookup                   bool
+	EnumLookupModelName            string
+	StrictEnum                     bool
+	IsEmbedded                     bool // the field's message is flattened into prefixed columns
+	Embedded                       bool // the field belongs to an embedded model rather than a message's model
+	IsCustomType                   bool
+	CustomToModel                  string
+	CustomToProto                  string
+	GoogleType                     string // the kind of google.type message stored, see googleTypeMap
+	GoogleTypeGoIdent              string
+	PostgisPoint                   bool
+	Columns                        []*ModelColumn // set when the field is stored in more than one column
+	Check                          string         // CHECK constraint expression derived from the field's validation rules
+	JsonbMarshalOptions            string         // protojson.MarshalOptions literal of jsonb and google.protobuf.Struct fields
 }
 
 func (f *ModelField) Parse() (err error) {
@@ -43,9 +60,38 @@ func (f *ModelField) Parse() (err error) {
 	f.IsOptional = isOptional(f.Field)
 	f.IsStructPb = isStructPb(f.Field)
 	f.IsJsonb = hasJsonbOption(f.Field)
+	if f.IsStructPb || f.IsJsonb {
+		f.JsonbMarshalOptions = getJsonbMarshalOptions(f)
+	}
 	f.Comments = f.Field.Comments.Leading.String() + f.Field.Comments.Trailing.String()
+	if f.Options.CustomType != nil {
+		if err = f.parseCustomType(); err != nil {
+			return
+		}
+	}
+	if f.Options.Embedded {
+		if !f.IsMessage || f.IsRepeated || f.IsTimestamp || f.IsStructPb || f.IsJsonb {
+			return fmt.Errorf("field %s: embedded is only supported on singular message fields", f.Desc.FullName())
+		}
+		if f.Message.GoIdent.GoImportPath != f.Parent.GoIdent.GoImportPath {
+			// the embedded model's ToGormEmbedded method can't be declared on a message from another go package
+			return fmt.Errorf("field %s: embedded messages must be in the same go package as the message embedding them", f.Desc.FullName())
+		}
+		f.IsEmbedded = true
+	}
+	if isGoogleType(f.Field) && !f.IsCustomType && !f.IsJsonb {
+		if err = f.parseGoogleType(); err != nil {
+			return
+		}
+	}
+	if f.Enum != nil {
+		if err = f.parseEnum(); err != nil {
+			return
+		}
+	}
 	f.ModelType = getModelFieldType(f)
 	f.ModelSingularType = getModelFieldSingularType(f)
+	f.Check = getCheckConstraint(f)
 	f.Tag = getFieldTags(f)
 	f.ShouldGenerateBelongsToIdField = shouldGenerateBelongsToIdField(f)
 	f.HasReplaceRelationships = hasReplaceRelationships(f)
@@ -54,6 +100,25 @@ func (f *ModelField) Parse() (err error) {
 	return
 }
 
+func (f *ModelField) parseEnum() (err error) {
+	if f.EnumStrategy, err = getEnumStrategy(f); err != nil {
+		return
+	}
+	if f.IsRepeated && f.EnumStrategy == gorm.EnumStrategy_LOOKUP_TABLE {
+		return fmt.Errorf("field %s: the lookup_table enum strategy is not supported on repeated fields", f.Desc.FullName())
+	}
+	f.EnumAsString = f.EnumStrategy == gorm.EnumStrategy_STRING || f.EnumStrategy == gorm.EnumStrategy_NATIVE
+	f.EnumAsLookup = f.EnumStrategy == gorm.EnumStrategy_LOOKUP_TABLE
+	if f.EnumAsLookup {
+		f.EnumLookupModelName = getEnumLookupModelName(f.Enum)
+	}
+	f.StrictEnum = isStrictEnum(f)
+	if f.StrictEnum && !f.EnumAsString {
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "fmt"})
+	}
+	return
+}
+
 func shouldGenerateBelongsToIdField(f *ModelField) bool {
 	options := f.Options
 	// no options or no belongs to means don't generate a belongs to field
@@ -91,7 +156,9 @@ func hasJsonbOption(field *protogen.Field) bool {
 }
 
 func getModelFieldType(field *ModelField) string {
-	if field.IsTimestamp {
+	if field.IsCustomType {
+		return getCustomModelFieldType(field)
+	} else if field.IsTimestamp {
 		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "time"})
 		if field.IsMessage {
 			g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/samber/lo"})
@@ -104,22 +171,29 @@ func getModelFieldType(field *ModelField) string {
 		}
 		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "time"})
 		return "*time.Time"
+	} else if field.IsEmbedded {
+		return getEmbeddedModelName(field.Message)
+	} else if field.GoogleType != "" {
+		return getGoogleTypeModelFieldType(field)
 	} else if field.IsStructPb || field.IsJsonb {
 		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/dariubs/gorm-jsonb"})
-		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "encoding/json"})
+		if field.IsRepeated {
+			// repeated fields are stored as a json array, see the serializer in getGormFieldTag
+			return "[]gorm_jsonb.JSONB"
+		}
 		return "gorm_jsonb.JSONB"
 	} else if field.IsMessage {
 		return getMessageGormModelFieldType(field.Field)
 	} else if field.Enum != nil {
 		if field.IsRepeated {
 			g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/lib/pq"})
-			if field.Options.EnumAsString {
+			if field.EnumAsString {
 				return "pq.StringArray"
 			} else {
 				return "pq.Int32Array"
 			}
 		}
-		if field.Options.EnumAsString {
+		if field.EnumAsString {
 			return "string"
 		}
 		return "int"
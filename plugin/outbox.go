@@ -0,0 +1,28 @@
+package plugin
+
+import (
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// parseOutbox enables the outbox events of messages with the outbox option
+func (m *Model) parseOutbox() {
+	if !getMessageOptions(m.Message).GetOutbox() {
+		return
+	}
+	m.Outbox = true
+	for _, importPath := range []protogen.GoImportPath{gormTypesImportPath, "github.com/samber/lo",
+		"google.golang.org/protobuf/proto"} {
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: importPath})
+	}
+}
+
+// hasOutbox is true when one of the messages has the outbox option, the generic upsert and delete then insert the
+// outbox events of models with it
+func hasOutbox(messages []*PreparedMessage) bool {
+	for _, message := range messages {
+		if message.Model.Outbox {
+			return true
+		}
+	}
+	return false
+}
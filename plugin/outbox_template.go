@@ -0,0 +1,77 @@
+package plugin
+
+import "text/template"
+
+// outboxTemplate renders the function inserting the outbox events of a message with the outbox option
+var outboxTemplate = template.Must(template.New("outbox").Funcs(templateFuncs).Parse(`
+{{- $message := .GoIdent.GoName }}
+// RecordOutbox runs the write in a transaction, and inserts an outbox event for each of the rows with the given ids the
+// write created, updated or deleted, see record{{ $message }}Outbox
+func (m *{{ .Model.Name }}) RecordOutbox(ctx context.Context, tx *gorm.DB, ids []string, write func(tx *gorm.DB) error) error {
+	return record{{ $message }}Outbox(ctx, tx, ids, write)
+}
+
+// record{{ $message }}Outbox runs the write in a transaction, and inserts an outbox event for each of the rows with the
+// given ids the write created, changed or deleted, with the {{ $message }} after the write, or before it for deletes.
+// The rows are locked before the write, see gormtypes.RelayOutbox
+func record{{ $message }}Outbox(ctx context.Context, tx *gorm.DB, ids []string, write func(tx *gorm.DB) error) error {
+	return tx.Transaction(func(tx *gorm.DB) error {
+		before, err := find{{ $message }}Models(ctx, tx.Clauses(clause.Locking{Strength: "UPDATE"}), ids)
+		if err != nil {
+			return err
+		}
+		if err = write(tx); err != nil {
+			return err
+		}
+		after, err := find{{ $message }}Models(ctx, tx, ids)
+		if err != nil {
+			return err
+		}
+		events := []*gormtypes.OutboxEvent{}
+		for _, id := range lo.Uniq(ids) {
+			model, operation := after[id], gormtypes.OutboxUpdate
+			switch {
+			case model == nil && before[id] == nil:
+				// there is no such row
+				continue
+			case model == nil:
+				model, operation = before[id], gormtypes.OutboxDelete
+			case before[id] == nil:
+				operation = gormtypes.OutboxCreate
+			}
+			theProto, err := model.ToProto()
+			if err != nil {
+				return err
+			}
+			if operation == gormtypes.OutboxUpdate {
+				previous, err := before[id].ToProto()
+				if err != nil {
+					return err
+				}
+				{{- if .Model.HasTimestamp "UpdatedAt" }}
+				// upserts set updated_at even when nothing else changes
+				previous.UpdatedAt = theProto.UpdatedAt
+				{{- end }}
+				if proto.Equal(previous, theProto) {
+					// the write didn't change the row
+					continue
+				}
+			}
+			payload, err := proto.Marshal(theProto)
+			if err != nil {
+				return err
+			}
+			events = append(events, &gormtypes.OutboxEvent{
+				MessageName: "{{ .Desc.FullName }}",
+				RecordId:    id,
+				Operation:   operation,
+				Payload:     payload,
+			})
+		}
+		if len(events) == 0 {
+			return nil
+		}
+		return tx.Create(&events).Error
+	})
+}
+`))
@@ -0,0 +1,151 @@
+package plugin
+
+import (
+	"path"
+	"sort"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// GoPackage collects the ormable messages of every generated file in a go package. The generics, enum helpers and
+// embedded models are generated once per package, so several proto files can share a go package
+type GoPackage struct {
+	GoImportPath  protogen.GoImportPath
+	GoPackageName protogen.GoPackageName
+	Files         []*protogen.File
+	Messages      []*PreparedMessage
+}
+
+type tplPackageHeader struct {
+	*GoPackage
+}
+
+const packageFileName = "package.pb.gorm.go"
+
+// fallbackPackageFileName is the package file's name when a package.proto file of the package is generated into
+// packageFileName. The files of proto files end in .pb.gorm.go, so it can't collide with them
+const fallbackPackageFileName = "package.gorm.go"
+
+var goPackages = map[protogen.GoImportPath]*GoPackage{}
+
+func addToGoPackage(file *protogen.File, messages []*PreparedMessage) {
+	goPackage, ok := goPackages[file.GoImportPath]
+	if !ok {
+		goPackage = &GoPackage{GoImportPath: file.GoImportPath, GoPackageName: file.GoPackageName}
+		goPackages[file.GoImportPath] = goPackage
+	}
+	goPackage.Files = append(goPackage.Files, file)
+	goPackage.Messages = append(goPackage.Messages, messages...)
+}
+
+// GoPackages returns the go packages of the files passed to ApplyTemplate, sorted by import path
+func GoPackages() []*GoPackage {
+	packages := []*GoPackage{}
+	for _, goPackage := range goPackages {
+		packages = append(packages, goPackage)
+	}
+	sort.Slice(packages, func(i, j int) bool {
+		return packages[i].GoImportPath < packages[j].GoImportPath
+	})
+	return packages
+}
+
+// GeneratedFilename is the package file's name, in the directory of the package's first file
+func (p *GoPackage) GeneratedFilename() string {
+	dir := path.Dir(p.Files[0].GeneratedFilenamePrefix)
+	for _, file := range p.Files {
+		if fileName(file) == path.Join(dir, packageFileName) {
+			return path.Join(dir, fallbackPackageFileName)
+		}
+	}
+	return path.Join(dir, packageFileName)
+}
+
+// ApplyPackageTemplate renders the package file. Embedded models and enums are prepared again against the package
+// file, so the package file only imports what its own code uses
+func ApplyPackageTemplate(gf *protogen.GeneratedFile, goPackage *GoPackage) (err error) {
+	g = gf
+	if err = loadUserTemplates(); err != nil {
+		return
+	}
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "context"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "gorm.io/gorm"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/google/uuid"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "gorm.io/gorm/clause"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "sync"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/samber/lo"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "gorm.io/gorm/schema"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "google.golang.org/protobuf/proto"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: gormTypesImportPath})
+	if err = getTemplate("package").Execute(gf, tplPackageHeader{GoPackage: goPackage}); err != nil {
+		return
+	}
+	var embeddedModels []*EmbeddedModel
+	if embeddedModels, err = prepareEmbeddedModels(goPackage.Messages); err != nil {
+		return
+	}
+	if len(embeddedModels) > 0 {
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "reflect"})
+	}
+	for _, embeddedModel := range embeddedModels {
+		if err = getTemplate("embedded").Execute(gf, embeddedModel); err != nil {
+			return
+		}
+	}
+	if enums := prepareEnums(goPackage.Messages, embeddedModels); len(enums) > 0 {
+		err = getTemplate("enums").Execute(gf, map[string]interface{}{"enums": enums, "migrate": enumsNeedMigration(enums)})
+		if err != nil {
+			return err
+		}
+	}
+	tenants := hasTenants(goPackage.Messages)
+	if tenants {
+		g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "fmt"})
+	}
+	conflicts := hasConflictOptions(goPackage.Messages)
+	if conflicts {
+		for _, importPath := range []protogen.GoImportPath{"fmt", "reflect", "strings"} {
+			g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: importPath})
+		}
+	}
+	err = getTemplate("generics").Funcs(genericsTemplateFuncs).Execute(gf, map[string]interface{}{"messages": goPackage.Messages, "tables": tables(goPackage.Messages), "tenants": tenants,
+		"history": hasHistory(goPackage.Messages), "outbox": hasOutbox(goPackage.Messages),
+		"rowLevelSecurity": hasRowLevelSecurity(goPackage.Messages), "conflicts": conflicts})
+	if err != nil {
+		return err
+	}
+	if *fakes {
+		for _, importPath := range []protogen.GoImportPath{"fmt", "reflect", "sort", "strings", "time", "github.com/samber/lo"} {
+			g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: importPath})
+		}
+		err = getTemplate("fake_store").Execute(gf, map[string]interface{}{"messages": goPackage.Messages, "joinTables": fakeJoinTables(goPackage.Messages)})
+		if err != nil {
+			return err
+		}
+	}
+	var services []*CrudService
+	if services, err = prepareCrudServices(goPackage); err != nil {
+		return
+	}
+	if len(services) > 0 {
+		for _, importPath := range []protogen.GoImportPath{"errors", "strings", "strconv", "encoding/base64", "github.com/samber/lo",
+			"google.golang.org/grpc/codes", "google.golang.org/grpc/status", "google.golang.org/protobuf/proto",
+			"google.golang.org/protobuf/reflect/protoreflect", "google.golang.org/protobuf/types/known/fieldmaskpb"} {
+			g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: importPath})
+		}
+		if err = getTemplate("service_helpers").Execute(gf, map[string]interface{}{"tenants": tenants}); err != nil {
+			return
+		}
+		for _, service := range services {
+			if err = getTemplate("service").Execute(gf, service); err != nil {
+				return
+			}
+		}
+	}
+	for _, packageTemplate := range userTemplates.Package {
+		if err = packageTemplate.Execute(gf, map[string]interface{}{"files": goPackage.Files, "messages": goPackage.Messages, "embeddedModels": embeddedModels}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -12,14 +12,16 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
+	"gorm.io/gorm/schema"
 	"strings"
 	"text/template"
 )
 
 // .
 var (
-	enumsAsInts = flag.Bool("enums_as_ints", false, "render enums as integers as opposed to strings")
-	engine      = flag.String("engine", "postgres", "database to render templates for, supported engines are 'postgres' and 'cockroachdb'")
+	enumsAsInts  = flag.Bool("enums_as_ints", false, "deprecated, equivalent to enum_strategy=int")
+	enumStrategy = flag.String("enum_strategy", "int", "default enum storage strategy, supported strategies are 'int', 'string', 'native' and 'lookup_table'")
+	engine       = flag.String("engine", "postgres", "database to render templates for, supported engines are 'postgres' and 'cockroachdb'")
 )
 
 type tplHeader struct {
@@ -51,6 +53,7 @@ var templateFuncs = map[string]any{
 	"gormModelName":         gormModelName,
 	"tableName":             tableName,
 	"emptyTag":              emptyTag,
+	"goIdent":               goIdent,
 }
 
 var genericsTemplateFuncs = template.FuncMap{
@@ -63,10 +66,14 @@ func pipe(index int, messages []*PreparedMessage) bool {
 
 var g *protogen.GeneratedFile
 
+// ApplyTemplate renders the per message code of a file. The code shared by the files of a go package is rendered by
+// ApplyPackageTemplate once every file has been applied
 func ApplyTemplate(gf *protogen.GeneratedFile, f *protogen.File) (err error) {
 	g = gf
-	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "sync"})
-	if err = headerTemplate.Execute(gf, tplHeader{
+	if err = loadUserTemplates(); err != nil {
+		return
+	}
+	if err = getTemplate("header").Execute(gf, tplHeader{
 		File: f,
 	}); err != nil {
 		return
@@ -79,19 +86,48 @@ func ApplyTemplate(gf *protogen.GeneratedFile, f *protogen.File) (err error) {
 	if err != nil {
 		return err
 	}
-	err = genericsTemplate.Funcs(genericsTemplateFuncs).Execute(gf, map[string]interface{}{"messages": preparedMessages})
-	if err != nil {
-		return err
+	for _, fileTemplate := range userTemplates.File {
+		if err = fileTemplate.Execute(gf, map[string]interface{}{"file": f, "messages": preparedMessages}); err != nil {
+			return err
+		}
 	}
+	addToGoPackage(f, preparedMessages)
 	return nil
 }
 
 func applyMessages(gf *protogen.GeneratedFile, messages []*PreparedMessage) (err error) {
 	for _, m := range messages {
 		glog.V(2).Infof("Processing %s", m.GoIdent.GoName)
-		if err := messageTemplate.Execute(gf, m); err != nil {
+		if err := getTemplate("message").Execute(gf, m); err != nil {
+			return err
+		}
+		if m.Model.History {
+			if err := getTemplate("history").Execute(gf, m); err != nil {
+				return err
+			}
+		}
+		if m.Model.Outbox {
+			if err := getTemplate("outbox").Execute(gf, m); err != nil {
+				return err
+			}
+		}
+		if err := getTemplate("repository").Execute(gf, m); err != nil {
 			return err
 		}
+		if *fakes {
+			g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "google.golang.org/protobuf/proto"})
+			if m.Model.HasTimestamp("CreatedAt") || m.Model.HasTimestamp("UpdatedAt") {
+				g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "time"})
+			}
+			if err := getTemplate("fake").Execute(gf, m); err != nil {
+				return err
+			}
+		}
+		for _, messageTemplate := range userTemplates.Message {
+			if err := messageTemplate.Execute(gf, m); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -124,6 +160,12 @@ func getModel(message *protogen.Message) Model {
 	}
 }
 
+// goIdent qualifies a proto message or enum type when it's rendered, so its package is only imported when the type is
+// used
+func goIdent(ident protogen.GoIdent) string {
+	return g.QualifiedGoIdent(ident)
+}
+
 func fileName(file *protogen.File) string {
 	return file.GeneratedFilenamePrefix + ".pb.gorm.go"
 }
@@ -212,7 +254,8 @@ func getPrimitiveGormModelFieldType(field *protogen.Field) (fieldType string) {
 
 func getMessageGormModelFieldType(field *protogen.Field) (fieldType string) {
 	pointer := pointer(field)
-	goType := gormModelName(field.Message)
+	// the message may be in another file or go package, so the model is qualified by the message's package
+	goType := g.QualifiedGoIdent(protogen.GoIdent{GoName: getModelNameFromMessage(field.Message), GoImportPath: field.Message.GoIdent.GoImportPath})
 	if isTimestamp(field) {
 		g.QualifiedGoIdent(protogen.GoIdent{
 			GoName:       "",
@@ -242,19 +285,30 @@ func getFieldTags(field *ModelField) string {
 
 func getGormFieldTag(field *ModelField) string {
 	tag := "gorm:\""
-	if isIdField(field.Field) {
-		tag += "type:uuid;primaryKey;"
-		if *engine == "postgres" {
-			tag += "default:uuid_generate_v4();"
-		} else {
-			tag += "default:gen_random_uuid();;"
+	if isIdField(field.Field) && !field.Embedded {
+		tag += getIdTag()
+	} else if field.IsCustomType {
+		if field.Options.CustomType.ColumnType != "" {
+			tag += fmt.Sprintf("type:%s;", field.Options.CustomType.ColumnType)
 		}
 	} else if isTimestamp(field.Field) {
 		tag += "type:timestamp;"
+	} else if field.IsEmbedded {
+		tag += fmt.Sprintf("embedded;embeddedPrefix:%s;", getEmbeddedPrefix(field))
+	} else if field.GoogleType != "" {
+		if columnType := getGoogleTypeColumnType(field); columnType != "" {
+			tag += fmt.Sprintf("type:%s;", columnType)
+		}
 	} else if isStructPb(field.Field) || hasJsonbOption(field.Field) {
-		tag += fmt.Sprintf("type:jsonb")
+		tag += "type:jsonb;"
+		if isRepeated(field.Field) {
+			// gorm_jsonb.JSONB only handles objects, so slices of them are serialized as a json array
+			tag += "serializer:json;"
+		}
+	} else if field.Enum != nil && field.EnumStrategy == gorm.EnumStrategy_NATIVE {
+		tag += fmt.Sprintf("type:%s%s;", getEnumTypeName(field.Enum), slice(field.Field))
 	} else if isRepeated(field.Field) && field.Enum != nil {
-		tag += fmt.Sprintf("type:%s;", repeatedEnumTypeMap[*engine][field.Options.EnumAsString])
+		tag += fmt.Sprintf("type:%s;", repeatedEnumTypeMap[*engine][field.EnumAsString])
 	} else if isRepeated(field.Field) && !isMessage(field.Field) {
 		tag += fmt.Sprintf("type:%s;", gormTagTypeMap[*engine][fieldKind(field.Field)])
 	}
@@ -286,9 +340,21 @@ func getGormFieldTag(field *ModelField) string {
 			}
 		}
 	}
+	tag += getCheckTag(field)
+	tag += getConflictTag(field)
+	tag += getIndexTag(field)
+	tag += getImmutableTag(field)
 	return tag + "\""
 }
 
+// getIdTag returns the gorm tag settings of uuid primary keys generated by the database
+func getIdTag() string {
+	if *engine == "postgres" {
+		return "type:uuid;primaryKey;default:uuid_generate_v4();"
+	}
+	return "type:uuid;primaryKey;default:gen_random_uuid();;"
+}
+
 func isIdField(field *protogen.Field) bool {
 	return strings.ToLower(string(field.Desc.Name())) == "id"
 }
@@ -579,6 +645,12 @@ func getMessageOptions(message *protogen.Message) *gorm.GormMessageOptions {
 	return opts
 }
 
+// getColumnName returns the column gorm stores the Go field with the given name in, its naming strategy doesn't always
+// agree with strcase, e.g. Int32S is stored in int32_s
+func getColumnName(goName string) string {
+	return schema.NamingStrategy{}.ColumnName("", goName)
+}
+
 func getFieldOptions(field *protogen.Field) *gorm.GormFieldOptions {
 	if field.Desc.Options() == nil {
 		// return empty options
@@ -32,6 +32,8 @@ func (pm *PreparedMessage) Parse() (err error) {
 	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "gorm.io/gorm"})
 	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/google/uuid"})
 	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "gorm.io/gorm/clause"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: "github.com/samber/lo"})
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: gormTypesImportPath})
 	model := &Model{Message: pm.Message}
 	if err = model.Parse(); err != nil {
 		return
@@ -47,6 +49,9 @@ func getModelNameFromMessage(message *protogen.Message) string {
 
 func getTableNameFromMessage(message *protogen.Message) string {
 	options := getMessageOptions(message)
+	if options.GetView().GetName() != "" {
+		return options.View.Name
+	}
 	if options != nil && options.Table != "" {
 		return options.Table
 	}
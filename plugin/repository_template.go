@@ -0,0 +1,71 @@
+package plugin
+
+import "text/template"
+
+var repositoryTemplate = template.Must(template.New("repository").Funcs(templateFuncs).Parse(`
+// {{ .GoIdent.GoName }}Repository {{ if .Model.View }}reads{{ else }}reads and writes{{ end }} {{ .GoIdent.GoName }}s, so services can depend on it rather than on
+// gorm. {{ .GoIdent.GoName }}GormRepository is the gorm implementation
+type {{ .GoIdent.GoName }}Repository interface {
+	GetByIds(ctx context.Context, ids []string, preloads ...string) ({{ .GoIdent.GoName }}Protos, error)
+	List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) ({{ .GoIdent.GoName }}Protos, error)
+	{{- if not .Model.View }}
+	// Upsert creates or updates the protos, without their associations, and returns them with their ids set
+	Upsert(ctx context.Context, protos {{ .GoIdent.GoName }}Protos) ({{ .GoIdent.GoName }}Protos, error)
+	// Delete deletes the rows of the ids, and returns the deleted rows without their associations
+	Delete(ctx context.Context, ids []string) ({{ .GoIdent.GoName }}Protos, error)
+	{{- end }}
+	{{- range .Model.ManyToManyFields }}
+	Associate{{ .GoName }}(ctx context.Context, associations *ManyToManyAssociations) error
+	Dissociate{{ .GoName }}(ctx context.Context, associations *ManyToManyAssociations) error
+	Replace{{ .GoName }}(ctx context.Context, associations *ManyToManyAssociations) error
+	{{- end }}
+}
+
+// {{ .GoIdent.GoName }}GormRepository is the {{ .GoIdent.GoName }}Repository backed by the generated gorm functions, its db may be a transaction
+type {{ .GoIdent.GoName }}GormRepository struct {
+	db *gorm.DB
+}
+
+var _ {{ .GoIdent.GoName }}Repository = &{{ .GoIdent.GoName }}GormRepository{}
+
+func New{{ .GoIdent.GoName }}GormRepository(db *gorm.DB) *{{ .GoIdent.GoName }}GormRepository {
+	return &{{ .GoIdent.GoName }}GormRepository{db: db}
+}
+
+func (r *{{ .GoIdent.GoName }}GormRepository) GetByIds(ctx context.Context, ids []string, preloads ...string) (protos {{ .GoIdent.GoName }}Protos, err error) {
+	err = protos.GetByIds(ctx, r.db.WithContext(ctx), ids, preloads...)
+	return
+}
+
+func (r *{{ .GoIdent.GoName }}GormRepository) List(ctx context.Context, limit, offset int, order interface{}, preloads ...string) (protos {{ .GoIdent.GoName }}Protos, err error) {
+	err = protos.List(ctx, r.db.WithContext(ctx), limit, offset, order, preloads...)
+	return
+}
+
+{{- if not .Model.View }}
+
+func (r *{{ .GoIdent.GoName }}GormRepository) Upsert(ctx context.Context, protos {{ .GoIdent.GoName }}Protos) ({{ .GoIdent.GoName }}Protos, error) {
+	_, err := protos.Upsert(ctx, r.db.WithContext(ctx))
+	return protos, err
+}
+
+func (r *{{ .GoIdent.GoName }}GormRepository) Delete(ctx context.Context, ids []string) ({{ .GoIdent.GoName }}Protos, error) {
+	_, protos, err := Delete{{ .Model.Name }}s(ctx, r.db.WithContext(ctx), ids)
+	return protos, err
+}
+{{- end }}
+{{ $message := . }}
+{{- range .Model.ManyToManyFields }}
+func (r *{{ $message.GoIdent.GoName }}GormRepository) Associate{{ .GoName }}(ctx context.Context, associations *ManyToManyAssociations) error {
+	return AssociateManyToMany[*{{ $message.Model.Name }}, {{ .ModelSingularType }}](ctx, r.db.WithContext(ctx), associations, "{{ .GoName }}")
+}
+
+func (r *{{ $message.GoIdent.GoName }}GormRepository) Dissociate{{ .GoName }}(ctx context.Context, associations *ManyToManyAssociations) error {
+	return DissociateManyToMany[*{{ $message.Model.Name }}, {{ .ModelSingularType }}](ctx, r.db.WithContext(ctx), associations, "{{ .GoName }}")
+}
+
+func (r *{{ $message.GoIdent.GoName }}GormRepository) Replace{{ .GoName }}(ctx context.Context, associations *ManyToManyAssociations) error {
+	return ReplaceManyToMany[*{{ $message.Model.Name }}, {{ .ModelSingularType }}](ctx, r.db.WithContext(ctx), associations, "{{ .GoName }}")
+}
+{{ end }}
+`))
@@ -0,0 +1,118 @@
+package plugin
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	defaultSearchLanguage = "english"
+	defaultSearchWeight   = "D"
+	searchVectorColumn    = "search_vector"
+)
+
+// searchLanguagePattern matches the names of text search configurations, which are interpolated into the generated column
+var searchLanguagePattern = regexp.MustCompile(`^[a-z_]+$`)
+
+// SearchField is a column of a model's full text search
+type SearchField struct {
+	Column string
+	Weight string
+}
+
+// parseSearch collects the message's fields with the search option, by weight from highest to lowest
+func (m *Model) parseSearch() error {
+	for _, field := range m.Fields {
+		options := field.Options.GetSearch()
+		if options == nil {
+			continue
+		}
+		if field.Desc.Kind() != protoreflect.StringKind || field.IsRepeated || field.IsCustomType || field.IsEmbedded ||
+			field.Options.GetTimeFormatOverride() != "" {
+			return fmt.Errorf("field %s: search is only supported on singular string fields", field.Desc.FullName())
+		}
+		weight := strings.ToUpper(options.Weight)
+		if weight == "" {
+			weight = defaultSearchWeight
+		}
+		if !strings.Contains("ABCD", weight) || len(weight) != 1 {
+			return fmt.Errorf("field %s: unsupported search weight %s, supported weights are A, B, C and D", field.Desc.FullName(), options.Weight)
+		}
+		m.SearchFields = append(m.SearchFields, &SearchField{Column: getColumnName(field.GoName), Weight: weight})
+	}
+	if len(m.SearchFields) == 0 {
+		return nil
+	}
+	sort.SliceStable(m.SearchFields, func(i, j int) bool { return m.SearchFields[i].Weight < m.SearchFields[j].Weight })
+	if m.SearchLanguage = getMessageOptions(m.Message).GetSearchLanguage(); m.SearchLanguage == "" {
+		m.SearchLanguage = defaultSearchLanguage
+	}
+	if !searchLanguagePattern.MatchString(m.SearchLanguage) {
+		return fmt.Errorf("message %s: unsupported search_language %s", m.Desc.FullName(), m.SearchLanguage)
+	}
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: gormTypesImportPath})
+	return nil
+}
+
+// HasSearchVector is true when the model's search fields are stored in a generated tsvector column, on postgres
+func (m *Model) HasSearchVector() bool {
+	return len(m.SearchFields) > 0 && *engine == postgresEngine
+}
+
+// SearchVectorExpression returns the expression of the generated tsvector column, the search fields weighted and
+// concatenated
+func (m *Model) SearchVectorExpression() string {
+	vectors := make([]string, len(m.SearchFields))
+	for i, field := range m.SearchFields {
+		vectors[i] = fmt.Sprintf("setweight(to_tsvector('%s', coalesce(%s, '')), '%s')", m.SearchLanguage, field.Column, field.Weight)
+	}
+	return strings.Join(vectors, " || ")
+}
+
+// SearchVectorTag returns the tag of the generated tsvector column, which is read only and has a GIN index
+func (m *Model) SearchVectorTag() string {
+	return fmt.Sprintf("`gorm:\"type:tsvector GENERATED ALWAYS AS (%s) STORED;->;index:%s,type:gin;\" json:\"-\"`",
+		m.SearchVectorExpression(), m.searchIndexName())
+}
+
+// SearchDDL returns the statements adding the generated tsvector column and its GIN index to databases that aren't
+// migrated with AutoMigrate
+func (m *Model) SearchDDL() []string {
+	if !m.HasSearchVector() {
+		return nil
+	}
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ADD COLUMN IF NOT EXISTS %s tsvector GENERATED ALWAYS AS (%s) STORED", m.TableName,
+			searchVectorColumn, m.SearchVectorExpression()),
+		fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s USING GIN (%s)", m.searchIndexName(), m.TableName, searchVectorColumn),
+	}
+}
+
+// SearchMatch returns the condition matching rows whose search fields contain the @pattern named argument, used where
+// there's no tsvector column
+func (m *Model) SearchMatch() string {
+	conditions := make([]string, len(m.SearchFields))
+	for i, field := range m.SearchFields {
+		conditions[i] = fmt.Sprintf("%s ILIKE @pattern", field.Column)
+	}
+	return strings.Join(conditions, " OR ")
+}
+
+// SearchRank returns the expression ranking rows matched by SearchMatch by the highest weight of the fields matching,
+// lower ranks first
+func (m *Model) SearchRank() string {
+	rank := "CASE"
+	for _, field := range m.SearchFields {
+		rank += fmt.Sprintf(" WHEN %s ILIKE @pattern THEN %d", field.Column, field.Weight[0]-'A')
+	}
+	return rank + " END"
+}
+
+func (m *Model) searchIndexName() string {
+	return fmt.Sprintf("idx_%s_search", m.TableName)
+}
@@ -0,0 +1,178 @@
+package plugin
+
+import (
+	"fmt"
+
+	gorm "github.com/catalystcommunity/protoc-gen-go-gorm/options"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+	fieldMaskName   = "google.protobuf.FieldMask"
+)
+
+// CrudService is a service annotated with a resource, for which a gorm server implementation of the standard methods
+// is generated
+type CrudService struct {
+	*protogen.Service
+	Resource        *PreparedMessage
+	DefaultPageSize int32
+	MaxPageSize     int32
+	Create          *CrudMethod
+	Get             *CrudMethod
+	List            *CrudMethod
+	Update          *CrudMethod
+	Delete          *CrudMethod
+}
+
+// CrudMethod is a standard method of a CrudService, with the request and response fields the implementation uses
+type CrudMethod struct {
+	*protogen.Method
+	// ResourceField is the request's resource field for create and update methods, and the response's repeated
+	// resource field for list methods
+	ResourceField      *protogen.Field
+	IdField            *protogen.Field
+	PageSizeField      *protogen.Field
+	PageTokenField     *protogen.Field
+	OrderByField       *protogen.Field
+	NextPageTokenField *protogen.Field
+	UpdateMaskField    *protogen.Field
+}
+
+func getServiceOptions(service *protogen.Service) *gorm.GormServiceOptions {
+	options := service.Desc.Options()
+	if options == nil {
+		return nil
+	}
+	opts, ok := proto.GetExtension(options, gorm.E_ServiceOpts).(*gorm.GormServiceOptions)
+	if !ok || opts == nil || opts.Resource == "" {
+		return nil
+	}
+	return opts
+}
+
+// prepareCrudServices returns the annotated services of the package's files. Their resource must be an ormable
+// message of the package, and their standard methods must have the standard request and response fields
+func prepareCrudServices(goPackage *GoPackage) (services []*CrudService, err error) {
+	for _, file := range goPackage.Files {
+		for _, service := range file.Services {
+			options := getServiceOptions(service)
+			if options == nil {
+				continue
+			}
+			crudService := &CrudService{
+				Service:         service,
+				DefaultPageSize: options.DefaultPageSize,
+				MaxPageSize:     options.MaxPageSize,
+			}
+			if crudService.DefaultPageSize <= 0 {
+				crudService.DefaultPageSize = defaultPageSize
+			}
+			if crudService.MaxPageSize <= 0 {
+				crudService.MaxPageSize = maxPageSize
+			}
+			for _, message := range goPackage.Messages {
+				if message.GoIdent.GoName == options.Resource || string(message.Desc.FullName()) == options.Resource {
+					crudService.Resource = message
+				}
+			}
+			if crudService.Resource == nil {
+				return nil, fmt.Errorf("resource %s of service %s is not an ormable message of its go package", options.Resource, service.Desc.FullName())
+			}
+			if err = crudService.prepareMethods(); err != nil {
+				return nil, err
+			}
+			services = append(services, crudService)
+		}
+	}
+	return
+}
+
+func (s *CrudService) prepareMethods() (err error) {
+	resource := s.Resource.GoIdent.GoName
+	for _, method := range s.Methods {
+		if method.Desc.IsStreamingClient() || method.Desc.IsStreamingServer() {
+			continue
+		}
+		crudMethod := &CrudMethod{Method: method}
+		switch method.GoName {
+		case "Create" + resource:
+			crudMethod.ResourceField, err = s.requireResourceField(method, method.Input, false)
+			if err == nil {
+				err = s.requireResourceOutput(method)
+			}
+			s.Create = crudMethod
+		case "Get" + resource:
+			crudMethod.IdField, err = requireField(method, method.Input, "id", protoreflect.StringKind)
+			if err == nil {
+				err = s.requireResourceOutput(method)
+			}
+			s.Get = crudMethod
+		case "List" + pluralizer.Plural(resource):
+			if crudMethod.PageSizeField, err = requireField(method, method.Input, "page_size", protoreflect.Int32Kind); err != nil {
+				return
+			}
+			if crudMethod.PageTokenField, err = requireField(method, method.Input, "page_token", protoreflect.StringKind); err != nil {
+				return
+			}
+			crudMethod.OrderByField, _ = requireField(method, method.Input, "order_by", protoreflect.StringKind)
+			if crudMethod.ResourceField, err = s.requireResourceField(method, method.Output, true); err != nil {
+				return
+			}
+			crudMethod.NextPageTokenField, err = requireField(method, method.Output, "next_page_token", protoreflect.StringKind)
+			s.List = crudMethod
+		case "Update" + resource:
+			crudMethod.ResourceField, err = s.requireResourceField(method, method.Input, false)
+			if err == nil {
+				err = s.requireResourceOutput(method)
+			}
+			for _, field := range method.Input.Fields {
+				if field.Desc.Name() == "update_mask" && field.Message != nil && field.Message.Desc.FullName() == fieldMaskName {
+					crudMethod.UpdateMaskField = field
+				}
+			}
+			s.Update = crudMethod
+		case "Delete" + resource:
+			crudMethod.IdField, err = requireField(method, method.Input, "id", protoreflect.StringKind)
+			s.Delete = crudMethod
+		}
+		if err != nil {
+			return
+		}
+		if s.Resource.Model.View && (crudMethod == s.Create || crudMethod == s.Update || crudMethod == s.Delete) {
+			return fmt.Errorf("%s can't be implemented, %s is backed by a view which is read only", method.Desc.FullName(), resource)
+		}
+	}
+	return
+}
+
+// requireField returns the message's singular field with the given name and kind
+func requireField(method *protogen.Method, message *protogen.Message, name string, kind protoreflect.Kind) (*protogen.Field, error) {
+	for _, field := range message.Fields {
+		if string(field.Desc.Name()) == name && field.Desc.Kind() == kind && !field.Desc.IsList() {
+			return field, nil
+		}
+	}
+	return nil, fmt.Errorf("%s of %s must have a %s %s field", message.Desc.FullName(), method.Desc.FullName(), kind, name)
+}
+
+// requireResourceField returns the message's field of the resource type
+func (s *CrudService) requireResourceField(method *protogen.Method, message *protogen.Message, repeated bool) (*protogen.Field, error) {
+	for _, field := range message.Fields {
+		if field.Message != nil && field.Message.Desc.FullName() == s.Resource.Desc.FullName() && field.Desc.IsList() == repeated {
+			return field, nil
+		}
+	}
+	return nil, fmt.Errorf("%s of %s must have a %s field", message.Desc.FullName(), method.Desc.FullName(), s.Resource.Desc.FullName())
+}
+
+func (s *CrudService) requireResourceOutput(method *protogen.Method) error {
+	if method.Output.Desc.FullName() != s.Resource.Desc.FullName() {
+		return fmt.Errorf("%s must return %s", method.Desc.FullName(), s.Resource.Desc.FullName())
+	}
+	return nil
+}
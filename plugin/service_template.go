@@ -0,0 +1,295 @@
+package plugin
+
+import "text/template"
+
+// serviceHelpersTemplate renders the helpers shared by the gorm server implementations of a go package
+var serviceHelpersTemplate = template.Must(template.New("service_helpers").Parse(`
+// GormErrorToStatus maps errors of the generated gorm functions to grpc status errors. Errors caused by the request,
+// like constraint violations and invalid values, keep their message, other errors are reported as Internal without
+// theirs. Status errors, and the typed errors of gormtypes.ClassifyError, are returned with their status
+func GormErrorToStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	{{- if .tenants }}
+	case errors.Is(err, gormtypes.ErrMissingTenant) || errors.Is(err, gormtypes.ErrTenantMismatch):
+		return status.Error(codes.PermissionDenied, err.Error())
+	{{- end }}
+	}
+	var withStatus interface{ GRPCStatus() *status.Status }
+	if errors.As(gormtypes.ClassifyError(err), &withStatus) {
+		return withStatus.GRPCStatus().Err()
+	}
+	// both pgx and lib/pq errors expose the postgres error code
+	var sqlStateErr interface{ SQLState() string }
+	if errors.As(err, &sqlStateErr) {
+		if state := sqlStateErr.SQLState(); state == "23502" || strings.HasPrefix(state, "22") {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	return status.Error(codes.Internal, "internal error")
+}
+
+// ListPage returns the limit and offset of a list rpc's page. A page size of 0 uses the default page size, and page
+// sizes are capped to the max page size. Page tokens are the base64 encoded offset of the page, see NextPageToken
+func ListPage(pageSize int32, pageToken string, defaultPageSize, maxPageSize int32) (limit, offset int, err error) {
+	switch {
+	case pageSize < 0:
+		return 0, 0, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	if pageToken != "" {
+		decoded, decodeErr := base64.RawURLEncoding.DecodeString(pageToken)
+		if decodeErr == nil {
+			offset, decodeErr = strconv.Atoi(string(decoded))
+		}
+		if decodeErr != nil || offset < 0 {
+			return 0, 0, status.Error(codes.InvalidArgument, "invalid page_token")
+		}
+	}
+	return int(pageSize), offset, nil
+}
+
+// NextPageToken returns the page token of the page starting at the offset
+func NextPageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// ListOrder validates a list rpc's comma separated order_by columns, with an optional asc or desc, against the
+// resource's columns as it's used in the query, and orders by id last so pages are stable
+func ListOrder(orderBy string, columns map[string]bool) (string, error) {
+	if strings.TrimSpace(orderBy) == "" {
+		return "id", nil
+	}
+	clauses := []string{}
+	for _, column := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(column)
+		if len(parts) == 0 || len(parts) > 2 || !columns[parts[0]] ||
+			(len(parts) == 2 && !strings.EqualFold(parts[1], "asc") && !strings.EqualFold(parts[1], "desc")) {
+			return "", status.Errorf(codes.InvalidArgument, "invalid order_by %q", column)
+		}
+		clauses = append(clauses, strings.Join(parts, " "))
+	}
+	return strings.Join(append(clauses, "id"), ", "), nil
+}
+
+// ApplyFieldMask sets the fields of the mask's paths on destination to their value in source, fields source doesn't
+// have are cleared. A path to a message field sets the whole message, a path into a message field only sets the
+// path's field of the message
+func ApplyFieldMask(destination, source proto.Message, mask *fieldmaskpb.FieldMask) error {
+	if !mask.IsValid(destination) {
+		return status.Errorf(codes.InvalidArgument, "invalid update_mask %v", mask.GetPaths())
+	}
+	source = proto.Clone(source)
+	for _, path := range mask.GetPaths() {
+		to, from := destination.ProtoReflect(), source.ProtoReflect()
+		names := strings.Split(path, ".")
+		for i, name := range names {
+			field := to.Descriptor().Fields().ByName(protoreflect.Name(name))
+			if i < len(names)-1 {
+				if !from.Has(field) && !to.Has(field) {
+					// neither has the parent message, so there is nothing to set or clear
+					break
+				}
+				// a parent message source doesn't have is empty, so only the path's field is cleared in destination's
+				to, from = to.Mutable(field).Message(), from.Get(field).Message()
+				continue
+			}
+			if from.Has(field) {
+				to.Set(field, from.Get(field))
+			} else {
+				to.Clear(field)
+			}
+			break
+		}
+	}
+	return nil
+}
+`))
+
+// serviceTemplate renders the gorm server implementation of a service annotated with a resource
+var serviceTemplate = template.Must(template.New("service").Funcs(templateFuncs).Parse(`
+{{- $resource := .Resource.GoIdent.GoName }}
+// {{ .GoName }}OrderColumns are the columns list rpcs of {{ .GoName }} can order by
+var {{ .GoName }}OrderColumns = map[string]bool{
+	{{- range .Resource.Model.OrderColumns }}
+	"{{ .Name }}": true,
+	{{- end }}
+}
+
+// {{ .GoName }}GormServer implements the standard rpcs of {{ .GoName }}Server for {{ $resource }}s with the generated
+// gorm functions, its other rpcs return Unimplemented. Errors are mapped to status errors by GormErrorToStatus
+type {{ .GoName }}GormServer struct {
+	Unimplemented{{ .GoName }}Server
+	db *gorm.DB
+}
+
+var _ {{ .GoName }}Server = &{{ .GoName }}GormServer{}
+
+func New{{ .GoName }}GormServer(db *gorm.DB) *{{ .GoName }}GormServer {
+	return &{{ .GoName }}GormServer{db: db}
+}
+
+// get{{ $resource }} returns the {{ $resource }} with its associations, or a NotFound error
+func (s *{{ .GoName }}GormServer) get{{ $resource }}(ctx context.Context, db *gorm.DB, id string) (*{{ $resource }}, error) {
+	if id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	protos := {{ $resource }}Protos{}
+	if err := protos.GetByIds(ctx, db, []string{id}); err != nil {
+		return nil, GormErrorToStatus(err)
+	}
+	if len(protos) == 0 {
+		return nil, status.Errorf(codes.NotFound, "{{ $resource }} %s not found", id)
+	}
+	return protos[0], nil
+}
+{{ with .Create }}
+// {{ .GoName }} creates the {{ $resource }} without its associations, a new id is set when it doesn't have one
+func (s *{{ $.GoName }}GormServer) {{ .GoName }}(ctx context.Context, request *{{ goIdent .Input.GoIdent }}) (*{{ $resource }}, error) {
+	resource := request.Get{{ .ResourceField.GoName }}()
+	if resource == nil {
+		return nil, status.Error(codes.InvalidArgument, "{{ .ResourceField.Desc.Name }} is required")
+	}
+	if resource.Id == nil {
+		resource.Id = lo.ToPtr(uuid.New().String())
+	}
+	model, err := resource.ToModel()
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	{{- if $.Resource.Model.TenantField }}
+	if err = AssignTenant(ctx, []*{{ $.Resource.Model.Name }}{model}); err != nil {
+		return nil, GormErrorToStatus(err)
+	}
+	{{- end }}
+	db := s.db.WithContext(ctx)
+	{{- if or $.Resource.Model.History $.Resource.Model.Outbox $.Resource.Model.RowLevelSecurity }}
+	{{- if or $.Resource.Model.History $.Resource.Model.Outbox }}
+	ids := []string{*model.Id}
+	{{- end }}
+	write := func(tx *gorm.DB) error {
+		return tx.Omit(clause.Associations).Create(model).Error
+	}
+	{{- if $.Resource.Model.Outbox }}
+	create := write
+	write = func(tx *gorm.DB) error {
+		return record{{ $resource }}Outbox(ctx, tx, ids, create)
+	}
+	{{- end }}
+	{{- if $.Resource.Model.History }}
+	unrecorded := write
+	write = func(tx *gorm.DB) error {
+		return record{{ $resource }}History(ctx, tx, ids, unrecorded)
+	}
+	{{- end }}
+	{{- if $.Resource.Model.RowLevelSecurity }}
+	// the insert must pass the row level security policy of {{ $.Resource.Model.TableName }}, so it's run with the tenant setting
+	err = WithTenantSetting[*{{ $.Resource.Model.Name }}](ctx, db, write)
+	{{- else }}
+	err = write(db)
+	{{- end }}
+	if err != nil {
+		return nil, GormErrorToStatus(err)
+	}
+	{{- else }}
+	if err = db.Omit(clause.Associations).Create(model).Error; err != nil {
+		return nil, GormErrorToStatus(err)
+	}
+	{{- end }}
+	return s.get{{ $resource }}(ctx, db, *resource.Id)
+}
+{{ end }}
+{{- with .Get }}
+func (s *{{ $.GoName }}GormServer) {{ .GoName }}(ctx context.Context, request *{{ goIdent .Input.GoIdent }}) (*{{ $resource }}, error) {
+	return s.get{{ $resource }}(ctx, s.db.WithContext(ctx), request.Get{{ .IdField.GoName }}())
+}
+{{ end }}
+{{- with .List }}
+// {{ .GoName }} returns a page of {{ $resource }}s, with a next page token unless it's the last page
+func (s *{{ $.GoName }}GormServer) {{ .GoName }}(ctx context.Context, request *{{ goIdent .Input.GoIdent }}) (*{{ goIdent .Output.GoIdent }}, error) {
+	limit, offset, err := ListPage(request.Get{{ .PageSizeField.GoName }}(), request.Get{{ .PageTokenField.GoName }}(), {{ $.DefaultPageSize }}, {{ $.MaxPageSize }})
+	if err != nil {
+		return nil, err
+	}
+	{{- if .OrderByField }}
+	order, err := ListOrder(request.Get{{ .OrderByField.GoName }}(), {{ $.GoName }}OrderColumns)
+	if err != nil {
+		return nil, err
+	}
+	{{- else }}
+	order := "id"
+	{{- end }}
+	protos := {{ $resource }}Protos{}
+	// fetch one more than the page to know whether there is a next page
+	if err = protos.List(ctx, s.db.WithContext(ctx), limit+1, offset, order); err != nil {
+		return nil, GormErrorToStatus(err)
+	}
+	response := &{{ goIdent .Output.GoIdent }}{}
+	if len(protos) > limit {
+		protos = protos[:limit]
+		response.{{ .NextPageTokenField.GoName }} = NextPageToken(offset + limit)
+	}
+	response.{{ .ResourceField.GoName }} = protos
+	return response, nil
+}
+{{ end }}
+{{- with .Update }}
+// {{ .GoName }} updates the {{ $resource }} without its associations
+{{- if .UpdateMaskField }}, only the fields in the update mask's paths when it has any{{ end }}
+func (s *{{ $.GoName }}GormServer) {{ .GoName }}(ctx context.Context, request *{{ goIdent .Input.GoIdent }}) (*{{ $resource }}, error) {
+	resource := request.Get{{ .ResourceField.GoName }}()
+	if resource == nil || resource.Id == nil {
+		return nil, status.Error(codes.InvalidArgument, "{{ .ResourceField.Desc.Name }}.id is required")
+	}
+	var updated *{{ $resource }}
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		existing, err := s.get{{ $resource }}(ctx, tx, *resource.Id)
+		if err != nil {
+			return err
+		}
+		{{- if .UpdateMaskField }}
+		if mask := request.Get{{ .UpdateMaskField.GoName }}(); len(mask.GetPaths()) > 0 {
+			if err = ApplyFieldMask(existing, resource, mask); err != nil {
+				return err
+			}
+			resource = existing
+		}
+		{{- end }}
+		protos := {{ $resource }}Protos{resource}
+		if _, err = protos.Upsert(ctx, tx); err != nil {
+			return err
+		}
+		updated, err = s.get{{ $resource }}(ctx, tx, *resource.Id)
+		return err
+	})
+	if err != nil {
+		return nil, GormErrorToStatus(err)
+	}
+	return updated, nil
+}
+{{ end }}
+{{- with .Delete }}
+// {{ .GoName }} deletes the {{ $resource }}, deleting a {{ $resource }} that doesn't exist succeeds
+func (s *{{ $.GoName }}GormServer) {{ .GoName }}(ctx context.Context, request *{{ goIdent .Input.GoIdent }}) (*{{ goIdent .Output.GoIdent }}, error) {
+	if request.Get{{ .IdField.GoName }}() == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if _, _, err := Delete{{ $.Resource.Model.Name }}s(ctx, s.db.WithContext(ctx), []string{request.Get{{ .IdField.GoName }}()}); err != nil {
+		return nil, GormErrorToStatus(err)
+	}
+	return &{{ goIdent .Output.GoIdent }}{}, nil
+}
+{{ end }}
+`))
@@ -0,0 +1,90 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/catalystcommunity/protoc-gen-go-gorm/gormtypes"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// parseTenant resolves the message's tenant_column option to the model field holding the tenant
+func (m *Model) parseTenant() error {
+	options := getMessageOptions(m.Message)
+	if options.GetTenantColumn() == "" {
+		if options.GetTenantRowLevelSecurity() {
+			return fmt.Errorf("message %s: tenant_row_level_security requires a tenant_column", m.Desc.FullName())
+		}
+		return nil
+	}
+	for _, field := range m.Fields {
+		if string(field.Desc.Name()) != options.TenantColumn {
+			continue
+		}
+		if field.Desc.Kind() != protoreflect.StringKind || field.IsRepeated || field.IsTimestamp || field.IsCustomType || field.IsEmbedded ||
+			field.Options.GetTimeFormatOverride() != "" {
+			return fmt.Errorf("message %s: tenant_column %s must be a string field", m.Desc.FullName(), options.TenantColumn)
+		}
+		m.TenantField = field
+	}
+	if m.TenantField == nil {
+		return fmt.Errorf("message %s: tenant_column %s is not a field of the message", m.Desc.FullName(), options.TenantColumn)
+	}
+	m.TenantColumn = getColumnName(m.TenantField.GoName)
+	m.RowLevelSecurity = options.TenantRowLevelSecurity
+	g.QualifiedGoIdent(protogen.GoIdent{GoImportPath: gormTypesImportPath})
+	return nil
+}
+
+// TenantOf returns the expression of the tenant of the given proto or model variable, which hold it the same way
+func (m *Model) TenantOf(variable string) string {
+	if m.TenantField.IsOptional {
+		return fmt.Sprintf("lo.FromPtr(%s.%s)", variable, m.TenantField.GoName)
+	}
+	return fmt.Sprintf("%s.%s", variable, m.TenantField.GoName)
+}
+
+// SetTenant returns the statement setting the tenant of the given proto or model variable to the tenant expression
+func (m *Model) SetTenant(variable, tenant string) string {
+	if m.TenantField.IsOptional {
+		return fmt.Sprintf("%s.%s = lo.ToPtr(%s)", variable, m.TenantField.GoName, tenant)
+	}
+	return fmt.Sprintf("%s.%s = %s", variable, m.TenantField.GoName, tenant)
+}
+
+// RowLevelSecurityDDL returns the statements enabling row level security on the model's table with a policy limiting
+// rows to the tenant of the tenant setting. The policy is forced so it applies to the table's owner too, and dropped
+// first so the statements can be run again
+func (m *Model) RowLevelSecurityDDL() []string {
+	if !m.RowLevelSecurity {
+		return nil
+	}
+	policy := fmt.Sprintf("%s_tenant_isolation", m.TableName)
+	condition := fmt.Sprintf("%s = current_setting('%s', true)", m.TenantColumn, gormtypes.TenantSetting)
+	return []string{
+		fmt.Sprintf("ALTER TABLE %s ENABLE ROW LEVEL SECURITY", m.TableName),
+		fmt.Sprintf("ALTER TABLE %s FORCE ROW LEVEL SECURITY", m.TableName),
+		fmt.Sprintf("DROP POLICY IF EXISTS %s ON %s", policy, m.TableName),
+		fmt.Sprintf("CREATE POLICY %s ON %s USING (%s) WITH CHECK (%s)", policy, m.TableName, condition, condition),
+	}
+}
+
+// hasRowLevelSecurity is true when one of the messages has the tenant_row_level_security option
+func hasRowLevelSecurity(messages []*PreparedMessage) bool {
+	for _, message := range messages {
+		if message.Model.RowLevelSecurity {
+			return true
+		}
+	}
+	return false
+}
+
+// hasTenants is true when one of the messages has a tenant column, the generic functions then scope models with one
+func hasTenants(messages []*PreparedMessage) bool {
+	for _, message := range messages {
+		if message.Model.TenantField != nil {
+			return true
+		}
+	}
+	return false
+}
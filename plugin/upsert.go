@@ -0,0 +1,112 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// parseUpsert resolves the message's conflict_columns option to the columns upserts conflict on, and validates its
+// immutable fields
+func (m *Model) parseUpsert() error {
+	options := getMessageOptions(m.Message)
+	for _, name := range options.GetConflictColumns() {
+		field, ok := lo.Find(m.Fields, func(field *ModelField) bool { return string(field.Desc.Name()) == name })
+		if !ok {
+			return fmt.Errorf("message %s: conflict column %s is not a field of the message", m.Desc.FullName(), name)
+		}
+		if isIdField(field.Field) || field.IsAssociation() || field.IsEmbedded || field.IsJsonb || field.IsStructPb ||
+			field.IsRepeated || len(field.Columns) > 0 {
+			return fmt.Errorf("message %s: conflict column %s must be a field stored in a single column", m.Desc.FullName(), name)
+		}
+		m.ConflictColumns = append(m.ConflictColumns, getColumnName(field.GoName))
+	}
+	m.UpsertDoNothing = options.GetUpsertDoNothing()
+	for _, field := range m.Fields {
+		if field.Options.GetImmutable() && (isIdField(field.Field) || field.IsAssociation() || field.IsEmbedded || len(field.Columns) > 0) {
+			return fmt.Errorf("field %s: immutable is only supported on fields stored in a single column other than the id", field.Desc.FullName())
+		}
+	}
+	return nil
+}
+
+// HasConflictOptions is true when the model's upserts don't use the default on conflict clause, see ConflictModel
+func (m *Model) HasConflictOptions() bool {
+	return len(m.ConflictColumns) > 0 || m.UpsertDoNothing
+}
+
+// ConflictTarget is the columns of the model's on conflict clause, the id when upserts do nothing without
+// conflict_columns, as updating a row on conflict requires a conflict target. The conflict columns of models with a
+// tenant column are unique per tenant, so the tenant column leads them
+func (m *Model) ConflictTarget() []string {
+	if len(m.ConflictColumns) == 0 && m.UpsertDoNothing {
+		return []string{"id"}
+	}
+	if m.TenantField != nil && len(m.ConflictColumns) > 0 {
+		return append([]string{m.TenantColumn}, m.ConflictColumns...)
+	}
+	return m.ConflictColumns
+}
+
+// ConflictTargetFields are the Go names of the fields of the model's conflict target other than the id, which fake
+// upserts compare rows by
+func (m *Model) ConflictTargetFields() (names []string) {
+	if len(m.ConflictColumns) == 0 {
+		return nil
+	}
+	for _, column := range m.ConflictTarget() {
+		if field, ok := lo.Find(m.Fields, func(field *ModelField) bool { return getColumnName(field.GoName) == column }); ok {
+			names = append(names, field.GoName)
+		}
+	}
+	return
+}
+
+// ImmutableFields are the model's fields with the immutable option, which upserts leave as they are
+func (m *Model) ImmutableFields() []*ModelField {
+	return lo.Filter(m.Fields, func(field *ModelField, _ int) bool { return field.Options.GetImmutable() })
+}
+
+// RecordsConflictingRows is true when the model's upserts record history or outbox events and update the rows the
+// models conflict with on the conflict columns, whose ids are then looked up before the write, see UpsertIds
+func (m *Model) RecordsConflictingRows() bool {
+	return len(m.ConflictColumns) > 0 && (m.History || m.Outbox)
+}
+
+// getConflictTag returns the unique index tag of a field of its message's conflict columns, or of its tenant column
+// when it has conflict columns, which upserts conflict on
+func getConflictTag(field *ModelField) string {
+	options := getMessageOptions(field.Parent)
+	name := string(field.Desc.Name())
+	if field.Embedded || len(options.GetConflictColumns()) == 0 ||
+		!(lo.Contains(options.GetConflictColumns(), name) || options.GetTenantColumn() == name) {
+		return ""
+	}
+	return fmt.Sprintf("uniqueIndex:%s;", getConflictIndexName(field.Parent))
+}
+
+// getConflictIndexName is the name of the unique index on the conflict columns of the message's table
+func getConflictIndexName(message *protogen.Message) string {
+	return fmt.Sprintf("idx_%s_conflict", getTableNameFromMessage(message))
+}
+
+// getImmutableTag returns gorm's create only permission tag for immutable fields, gorm then leaves them out of updates
+// and of the columns upserts update
+func getImmutableTag(field *ModelField) string {
+	if !field.Options.GetImmutable() {
+		return ""
+	}
+	return "<-:create;"
+}
+
+// hasConflictOptions is true when one of the messages has conflict options, the generic upsert then uses the on
+// conflict clause of models with them
+func hasConflictOptions(messages []*PreparedMessage) bool {
+	for _, message := range messages {
+		if message.Model.HasConflictOptions() {
+			return true
+		}
+	}
+	return false
+}
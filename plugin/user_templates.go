@@ -0,0 +1,144 @@
+package plugin
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+var templateDir = flag.String("template_dir", "", "directory of templates overriding the built in templates or adding per message and per file output")
+
+const (
+	userTemplateExtension     = ".tmpl"
+	userMessageTemplatePrefix = "message_"
+	userFileTemplatePrefix    = "file_"
+	userPackageTemplatePrefix = "package_"
+)
+
+// UserTemplates are the templates loaded from the template_dir plugin parameter. Templates named after a built in
+// template, e.g. message.tmpl, override it. Templates prefixed with message_, file_ and package_ are rendered once per
+// ormable message, file and go package, after the built in output
+type UserTemplates struct {
+	Overrides map[string]*template.Template
+	Message   []*template.Template
+	File      []*template.Template
+	Package   []*template.Template
+}
+
+var userTemplates *UserTemplates
+
+// builtInTemplates returns the templates that can be overridden, by file name without the extension
+func builtInTemplates() map[string]*template.Template {
+	return map[string]*template.Template{
+		"header":          headerTemplate,
+		"package":         packageHeaderTemplate,
+		"message":         messageTemplate,
+		"history":         historyTemplate,
+		"outbox":          outboxTemplate,
+		"repository":      repositoryTemplate,
+		"embedded":        embeddedTemplate,
+		"enums":           enumTemplate,
+		"fake":            fakeTemplate,
+		"fake_store":      fakeStoreTemplate,
+		"service":         serviceTemplate,
+		"service_helpers": serviceHelpersTemplate,
+		"generics":        genericsTemplate,
+	}
+}
+
+// userTemplateFuncs are available to every user template, on top of the functions of the template they override
+var userTemplateFuncs = template.FuncMap{
+	"qualifiedGoIdent": qualifiedGoIdent,
+}
+
+// qualifiedGoIdent returns the name qualified by its package, and adds the package to the generated file's imports
+func qualifiedGoIdent(importPath, name string) string {
+	return g.QualifiedGoIdent(protogen.GoIdent{GoName: name, GoImportPath: protogen.GoImportPath(importPath)})
+}
+
+// loadUserTemplates parses the templates in the template_dir once, the parsed templates are reused for every file
+func loadUserTemplates() (err error) {
+	if userTemplates != nil {
+		return
+	}
+	loaded := &UserTemplates{Overrides: map[string]*template.Template{}}
+	if *templateDir == "" {
+		userTemplates = loaded
+		return
+	}
+	var entries []os.DirEntry
+	if entries, err = os.ReadDir(*templateDir); err != nil {
+		return fmt.Errorf("reading template_dir: %w", err)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Name() < entries[j].Name()
+	})
+	builtIns := builtInTemplates()
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != userTemplateExtension {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), userTemplateExtension)
+		var content []byte
+		if content, err = os.ReadFile(filepath.Join(*templateDir, entry.Name())); err != nil {
+			return
+		}
+		if builtIn, ok := builtIns[name]; ok {
+			if loaded.Overrides[name], err = parseUserTemplate(builtIn, name, content); err != nil {
+				return
+			}
+			continue
+		}
+		var tpl *template.Template
+		switch {
+		case strings.HasPrefix(name, userMessageTemplatePrefix):
+			if tpl, err = parseUserTemplate(fieldTemplates, name, content); err != nil {
+				return
+			}
+			loaded.Message = append(loaded.Message, tpl)
+		case strings.HasPrefix(name, userFileTemplatePrefix):
+			if tpl, err = parseUserTemplate(fieldTemplates, name, content); err != nil {
+				return
+			}
+			loaded.File = append(loaded.File, tpl)
+		case strings.HasPrefix(name, userPackageTemplatePrefix):
+			if tpl, err = parseUserTemplate(fieldTemplates, name, content); err != nil {
+				return
+			}
+			loaded.Package = append(loaded.Package, tpl)
+		default:
+			return fmt.Errorf("template %s doesn't override a built in template or start with %s, %s or %s", entry.Name(), userMessageTemplatePrefix, userFileTemplatePrefix, userPackageTemplatePrefix)
+		}
+	}
+	userTemplates = loaded
+	return
+}
+
+// parseUserTemplate parses the content into a clone of the base template, so the base template's defined templates,
+// e.g. toProtoFields, can be used or redefined
+func parseUserTemplate(base *template.Template, name string, content []byte) (tpl *template.Template, err error) {
+	if tpl, err = base.Clone(); err != nil {
+		return
+	}
+	if tpl.Name() != name {
+		tpl = tpl.New(name)
+	}
+	if tpl, err = tpl.Funcs(templateFuncs).Funcs(genericsTemplateFuncs).Funcs(userTemplateFuncs).Parse(string(content)); err != nil {
+		return nil, fmt.Errorf("parsing template %s: %w", name, err)
+	}
+	return
+}
+
+// getTemplate returns the user's override of a built in template, or the built in template
+func getTemplate(name string) *template.Template {
+	if override, ok := userTemplates.Overrides[name]; ok {
+		return override
+	}
+	return builtInTemplates()[name]
+}
@@ -0,0 +1,49 @@
+package plugin
+
+import (
+	"fmt"
+
+	"github.com/samber/lo"
+)
+
+// parseView validates the options of view backed messages, which are read only so can't have the options of models
+// that are written
+func (m *Model) parseView() error {
+	options := getMessageOptions(m.Message)
+	if options.GetView() == nil {
+		return nil
+	}
+	m.View = true
+	m.ViewDefinition = options.View.Definition
+	switch {
+	case options.History:
+		return fmt.Errorf("message %s: views can't have the history option", m.Desc.FullName())
+	case options.Outbox:
+		return fmt.Errorf("message %s: views can't have the outbox option", m.Desc.FullName())
+	case options.TenantRowLevelSecurity:
+		return fmt.Errorf("message %s: views can't have the tenant_row_level_security option", m.Desc.FullName())
+	case options.UpsertBatchSize != 0 || len(options.ConflictColumns) > 0 || options.UpsertDoNothing:
+		return fmt.Errorf("message %s: views can't have upsert options", m.Desc.FullName())
+	case len(options.Indexes) > 0:
+		return fmt.Errorf("message %s: views can't have indexes", m.Desc.FullName())
+	case len(m.SearchFields) > 0:
+		return fmt.Errorf("message %s: views can't have search fields", m.Desc.FullName())
+	}
+	if field, ok := lo.Find(m.Fields, func(field *ModelField) bool { return field.Options.GetManyToMany() != nil }); ok {
+		return fmt.Errorf("field %s: views can't have many to many fields", field.Desc.FullName())
+	}
+	return nil
+}
+
+// ViewDDL returns the statement creating or replacing the model's view with its definition
+func (m *Model) ViewDDL() []string {
+	if m.ViewDefinition == "" {
+		return nil
+	}
+	return []string{fmt.Sprintf("CREATE OR REPLACE VIEW %s AS %s", m.TableName, m.ViewDefinition)}
+}
+
+// tables returns the messages backed by tables, the generic functions writing rows are limited to them
+func tables(messages []*PreparedMessage) []*PreparedMessage {
+	return lo.Filter(messages, func(message *PreparedMessage, _ int) bool { return !message.Model.View })
+}
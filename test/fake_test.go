@@ -0,0 +1,147 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	. "github.com/catalystcommunity/protoc-gen-go-gorm/example/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFakeRepository tests that the fake repository sets ids and timestamps and loads associations like the gorm
+// repository, without a database
+func TestFakeRepository(t *testing.T) {
+	ctx := context.Background()
+	store := NewFakeStore()
+	var repository UserRepository = NewUserFakeRepository(store)
+	user := getPostgresUser(t)
+	user.Id = nil
+	user.AString = "a"
+	upserted, err := repository.Upsert(ctx, UserProtos{user})
+	require.NoError(t, err)
+	require.Len(t, upserted, 1)
+	require.NotNil(t, upserted[0].Id)
+	// the upserted protos are the rows as stored
+	require.NotEmpty(t, upserted[0].CreatedAt)
+	fetched, err := repository.GetByIds(ctx, []string{*user.Id})
+	require.NoError(t, err)
+	require.Len(t, fetched, 1)
+	require.NotEmpty(t, fetched[0].CreatedAt)
+	require.Equal(t, user.AString, fetched[0].AString)
+	createdAt := fetched[0].CreatedAt
+	// associations
+	company := getPostgresCompany(t)
+	_, err = NewCompanyFakeRepository(store).Upsert(ctx, CompanyProtos{company})
+	require.NoError(t, err)
+	comments := getPostgresComments(t, 2)
+	for _, comment := range comments {
+		comment.UserId = user.Id
+	}
+	_, err = NewCommentFakeRepository(store).Upsert(ctx, comments)
+	require.NoError(t, err)
+	profiles := getPostgresProfiles(t, 2)
+	_, err = NewProfileFakeRepository(store).Upsert(ctx, profiles)
+	require.NoError(t, err)
+	user.CompanyId = company.Id
+	user.CreatedAt = ""
+	_, err = repository.Upsert(ctx, UserProtos{user})
+	require.NoError(t, err)
+	require.Equal(t, createdAt, user.CreatedAt)
+	associations := &ManyToManyAssociations{}
+	for _, profile := range profiles {
+		associations.AddAssociation(*user.Id, *profile.Id)
+	}
+	require.NoError(t, repository.AssociateProfiles(ctx, associations))
+	fetched, err = repository.GetByIds(ctx, []string{*user.Id}, "Profiles")
+	require.NoError(t, err)
+	require.Len(t, fetched, 1)
+	require.Equal(t, createdAt, fetched[0].CreatedAt)
+	require.Equal(t, company.Name, fetched[0].Company.Name)
+	require.Len(t, fetched[0].Comments, 2)
+	require.Len(t, fetched[0].Profiles, 2)
+	// deleting an associated row removes its associations
+	deletedProfiles, err := NewProfileFakeRepository(store).Delete(ctx, []string{*profiles[0].Id})
+	require.NoError(t, err)
+	require.Len(t, deletedProfiles, 1)
+	require.Equal(t, *profiles[0].Id, *deletedProfiles[0].Id)
+	fetched, err = repository.GetByIds(ctx, []string{*user.Id}, "Profiles")
+	require.NoError(t, err)
+	require.Len(t, fetched[0].Profiles, 1)
+	require.Equal(t, *profiles[1].Id, *fetched[0].Profiles[0].Id)
+	require.NoError(t, repository.DissociateProfiles(ctx, associations))
+	fetched, err = repository.GetByIds(ctx, []string{*user.Id})
+	require.NoError(t, err)
+	require.Empty(t, fetched[0].Profiles)
+	// list
+	other := getPostgresUser(t)
+	other.Id = nil
+	other.AString = "b"
+	_, err = repository.Upsert(ctx, UserProtos{other})
+	require.NoError(t, err)
+	listed, err := repository.List(ctx, 1, 0, "a_string desc")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, *other.Id, *listed[0].Id)
+	listed, err = repository.List(ctx, -1, 1, "a_string desc")
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, *user.Id, *listed[0].Id)
+	_, err = repository.List(ctx, -1, 0, "unknown_column")
+	require.Error(t, err)
+	// delete
+	deleted, err := repository.Delete(ctx, []string{*user.Id, *user.Id})
+	require.NoError(t, err)
+	require.Len(t, deleted, 1)
+	require.Equal(t, *user.Id, *deleted[0].Id)
+	require.Equal(t, user.AString, deleted[0].AString)
+	require.Empty(t, deleted[0].Profiles)
+	fetched, err = repository.GetByIds(ctx, []string{*user.Id})
+	require.NoError(t, err)
+	require.Empty(t, fetched)
+	// the fakes of views only read, like their repositories
+	var summaries interface{} = NewProductSummaryFakeRepository(store)
+	_, writes := summaries.(interface {
+		Delete(ctx context.Context, ids []string) (ProductSummaryProtos, error)
+	})
+	require.False(t, writes)
+}
+
+// TestFakeUpsertConflicts tests that fake upserts conflict on the conflict_columns, leave immutable fields as they are,
+// and leave existing rows as they are with upsert_do_nothing, like the gorm upserts
+func TestFakeUpsertConflicts(t *testing.T) {
+	ctx := context.Background()
+	store := NewFakeStore()
+	levels := NewStockLevelFakeRepository(store)
+	level := &StockLevel{Warehouse: "north", Sku: gofakeit.UUID(), Quantity: 10, FirstCountedBy: "alice"}
+	_, err := levels.Upsert(ctx, StockLevelProtos{level})
+	require.NoError(t, err)
+	// another level of the same warehouse and sku updates the quantity of the existing row, but not who first counted it
+	update := &StockLevel{Warehouse: "north", Sku: level.Sku, Quantity: 7, FirstCountedBy: "bob"}
+	upserted, err := levels.Upsert(ctx, StockLevelProtos{update})
+	require.NoError(t, err)
+	require.Equal(t, *level.Id, *upserted[0].Id)
+	require.Equal(t, level.CreatedAt, update.CreatedAt)
+	require.Equal(t, int64(7), update.Quantity)
+	require.Equal(t, "alice", update.FirstCountedBy)
+	// the same sku in another warehouse is another row
+	_, err = levels.Upsert(ctx, StockLevelProtos{{Warehouse: "south", Sku: level.Sku, Quantity: 1}})
+	require.NoError(t, err)
+	listed, err := levels.List(ctx, -1, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, listed, 2)
+	// reservations of an order that is already reserved are left as they are
+	reservations := NewStockReservationFakeRepository(store)
+	reservation := &StockReservation{OrderNumber: gofakeit.UUID(), Sku: level.Sku, Quantity: 2}
+	_, err = reservations.Upsert(ctx, StockReservationProtos{reservation})
+	require.NoError(t, err)
+	again := &StockReservation{OrderNumber: reservation.OrderNumber, Sku: level.Sku, Quantity: 5}
+	_, err = reservations.Upsert(ctx, StockReservationProtos{again})
+	require.NoError(t, err)
+	require.Equal(t, *reservation.Id, *again.Id)
+	require.Equal(t, int64(2), again.Quantity)
+	fetched, err := reservations.GetByIds(ctx, []string{*reservation.Id})
+	require.NoError(t, err)
+	require.Len(t, fetched, 1)
+	require.Equal(t, int64(2), fetched[0].Quantity)
+}
@@ -0,0 +1,170 @@
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/catalystcommunity/protoc-gen-go-gorm/gormtypes"
+	gorm_jsonb "github.com/dariubs/gorm-jsonb"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"gorm.io/gorm"
+)
+
+// TestMoneyAmount tests that money amounts round trip through their decimal string, keeping units and nanos signs equal
+func TestMoneyAmount(t *testing.T) {
+	for _, amount := range []struct {
+		units int64
+		nanos int32
+		text  string
+	}{
+		{0, 0, "0.000000000"},
+		{12, 500000000, "12.500000000"},
+		{-12, -340000000, "-12.340000000"},
+		{0, -5, "-0.000000005"},
+		{9223372036854775807, 999999999, "9223372036854775807.999999999"},
+	} {
+		require.Equal(t, amount.text, gormtypes.FormatMoneyAmount(amount.units, amount.nanos))
+		units, nanos, err := gormtypes.ParseMoneyAmount(amount.text)
+		require.NoError(t, err)
+		require.Equal(t, amount.units, units)
+		require.Equal(t, amount.nanos, nanos)
+	}
+	units, nanos, err := gormtypes.ParseMoneyAmount("-1.5")
+	require.NoError(t, err)
+	require.Equal(t, int64(-1), units)
+	require.Equal(t, int32(-500000000), nanos)
+	_, _, err = gormtypes.ParseMoneyAmount("1.0000000001")
+	require.Error(t, err)
+	_, _, err = gormtypes.ParseMoneyAmount("not a number")
+	require.Error(t, err)
+}
+
+// TestPoint tests that points are written as extended well known text and read from extended well known binary
+func TestPoint(t *testing.T) {
+	point := gormtypes.Point{Latitude: 40.7608, Longitude: -111.891}
+	value, err := point.Value()
+	require.NoError(t, err)
+	require.Equal(t, "SRID=4326;POINT(-111.891 40.7608)", value)
+	// SELECT 'SRID=4326;POINT(-111.891 40.7608)'::geography
+	const ewkb = "0101000020E61000001B2FDD2406F95BC0FE65F7E461614440"
+	scanned := gormtypes.Point{}
+	require.NoError(t, scanned.Scan(ewkb))
+	require.Equal(t, point, scanned)
+	scanned = gormtypes.Point{}
+	require.NoError(t, scanned.Scan([]byte(ewkb)))
+	require.Equal(t, point, scanned)
+	require.Error(t, scanned.Scan("0101000000"))
+}
+
+// TestTenantContext tests that the tenant of a context is read back, and that an empty tenant counts as no tenant
+func TestTenantContext(t *testing.T) {
+	_, ok := gormtypes.TenantFromContext(context.Background())
+	require.False(t, ok)
+	tenant, ok := gormtypes.TenantFromContext(gormtypes.WithTenant(context.Background(), "acme"))
+	require.True(t, ok)
+	require.Equal(t, "acme", tenant)
+	_, ok = gormtypes.TenantFromContext(gormtypes.WithTenant(context.Background(), ""))
+	require.False(t, ok)
+}
+
+// TestOutboxEventMessage tests that outbox event payloads are unmarshalled into a message of the event's message name
+func TestOutboxEventMessage(t *testing.T) {
+	payload, err := proto.Marshal(wrapperspb.String("created"))
+	require.NoError(t, err)
+	event := &gormtypes.OutboxEvent{MessageName: "google.protobuf.StringValue", Payload: payload}
+	message, err := event.Message()
+	require.NoError(t, err)
+	require.True(t, proto.Equal(wrapperspb.String("created"), message))
+	event.MessageName = "example.Unknown"
+	_, err = event.Message()
+	require.Error(t, err)
+}
+
+// TestOutboxRelayInterval tests that the outbox relay refuses intervals that would make it poll without waiting
+func TestOutboxRelayInterval(t *testing.T) {
+	publish := func(ctx context.Context, events []*gormtypes.OutboxEvent) error {
+		return nil
+	}
+	for _, interval := range []time.Duration{0, -time.Second} {
+		require.Error(t, gormtypes.RunOutboxRelay(context.Background(), nil, 10, interval, publish, nil))
+	}
+}
+
+// TestJSONB tests that messages are stored in jsonb values with their protojson options, and read back whatever the
+// options they were stored with
+func TestJSONB(t *testing.T) {
+	field := &descriptorpb.FieldDescriptorProto{Name: proto.String("created_at"), JsonName: proto.String("createdAt"),
+		Type: descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()}
+	value, err := gormtypes.MessageToJSONB(field, protojson.MarshalOptions{})
+	require.NoError(t, err)
+	require.Equal(t, gorm_jsonb.JSONB{"name": "created_at", "jsonName": "createdAt", "type": "TYPE_STRING"}, value)
+	value, err = gormtypes.MessageToJSONB(field, protojson.MarshalOptions{UseProtoNames: true, UseEnumNumbers: true})
+	require.NoError(t, err)
+	require.Equal(t, gorm_jsonb.JSONB{"name": "created_at", "json_name": "createdAt", "type": float64(9)}, value)
+	// fields the message no longer has are discarded
+	value["removed"] = true
+	read := &descriptorpb.FieldDescriptorProto{}
+	require.NoError(t, gormtypes.JSONBToMessage(value, read))
+	require.True(t, proto.Equal(field, read))
+}
+
+// TestEscapeLike tests that the wildcards and escape character of LIKE patterns are escaped
+func TestEscapeLike(t *testing.T) {
+	require.Equal(t, `50\% off`, gormtypes.EscapeLike("50% off"))
+	require.Equal(t, `snake\_case`, gormtypes.EscapeLike("snake_case"))
+	require.Equal(t, `C:\\temp`, gormtypes.EscapeLike(`C:\temp`))
+	require.Equal(t, "plain", gormtypes.EscapeLike("plain"))
+}
+
+// TestClassifyError tests that gorm's and the drivers' errors are classified into the typed errors of their kind, with
+// their grpc status
+func TestClassifyError(t *testing.T) {
+	require.Nil(t, gormtypes.ClassifyError(nil))
+	err := gormtypes.ClassifyError(fmt.Errorf("get: %w", gorm.ErrRecordNotFound))
+	require.ErrorIs(t, err, gormtypes.ErrNotFound)
+	require.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	require.Equal(t, codes.NotFound, status.Code(err))
+	// pgx errors
+	err = gormtypes.ClassifyError(&pgconn.PgError{Code: "23505", ConstraintName: "idx_stock_levels_conflict",
+		Detail: "Key (warehouse, sku)=(north, 1234) already exists."})
+	var unique *gormtypes.UniqueViolationError
+	require.ErrorAs(t, err, &unique)
+	require.ErrorIs(t, err, gormtypes.ErrUniqueViolation)
+	require.Equal(t, "idx_stock_levels_conflict", unique.Constraint)
+	require.Equal(t, []string{"warehouse", "sku"}, unique.Fields)
+	require.Equal(t, codes.AlreadyExists, status.Code(err))
+	err = gormtypes.ClassifyError(&pgconn.PgError{Code: "23503", ConstraintName: "fk_users_company"})
+	var foreignKey *gormtypes.ForeignKeyViolationError
+	require.ErrorAs(t, err, &foreignKey)
+	require.Equal(t, "fk_users_company", foreignKey.Constraint)
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	err = gormtypes.ClassifyError(&pgconn.PgError{Code: "40001"})
+	require.ErrorIs(t, err, gormtypes.ErrSerializationFailure)
+	require.Equal(t, codes.Aborted, status.Code(err))
+	// lib/pq errors
+	err = gormtypes.ClassifyError(fmt.Errorf("upsert: %w", &pq.Error{Code: "23514", Constraint: "chk_coupons_code"}))
+	var check *gormtypes.CheckViolationError
+	require.ErrorAs(t, err, &check)
+	require.Equal(t, "chk_coupons_code", check.Constraint)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	err = gormtypes.ClassifyError(&pq.Error{Code: "23505", Detail: `Key ("order")=(1) already exists.`})
+	require.ErrorAs(t, err, &unique)
+	require.Equal(t, []string{"order"}, unique.Fields)
+	// conversion errors and typed errors are returned as they are, like errors of other kinds
+	conversion := &gormtypes.ConversionError{Err: errors.New("parsing time")}
+	require.Same(t, conversion, gormtypes.ClassifyError(conversion))
+	require.Equal(t, codes.InvalidArgument, status.Code(conversion))
+	other := &pgconn.PgError{Code: "42P01"}
+	require.Same(t, other, gormtypes.ClassifyError(other))
+}
@@ -0,0 +1,43 @@
+package test
+
+import (
+	"testing"
+
+	. "github.com/catalystcommunity/protoc-gen-go-gorm/example/postgres"
+	"github.com/catalystcommunity/protoc-gen-go-gorm/plugin"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// TestPackageFilename tests that the package file of a go package doesn't collide with the file of a package.proto
+func TestPackageFilename(t *testing.T) {
+	goPackage := func(names ...string) *plugin.GoPackage {
+		request := &pluginpb.CodeGeneratorRequest{FileToGenerate: names, Parameter: proto.String("paths=source_relative")}
+		for _, name := range names {
+			request.ProtoFile = append(request.ProtoFile, &descriptorpb.FileDescriptorProto{
+				Name:    proto.String(name),
+				Package: proto.String("shop"),
+				Syntax:  proto.String("proto3"),
+				Options: &descriptorpb.FileOptions{GoPackage: proto.String("example.com/shop;shop")},
+			})
+		}
+		gp, err := protogen.Options{}.New(request)
+		require.NoError(t, err)
+		return &plugin.GoPackage{Files: gp.Files}
+	}
+	require.Equal(t, "shop/package.pb.gorm.go", goPackage("shop/order.proto", "shop/product.proto").GeneratedFilename())
+	require.Equal(t, "shop/package.gorm.go", goPackage("shop/order.proto", "shop/package.proto").GeneratedFilename())
+}
+
+// TestEmbeddedUnset tests that an unset embedded message is converted to zero columns and back to an unset message
+func TestEmbeddedUnset(t *testing.T) {
+	model, err := (&User{Billing: &PostalAddress{Street: "1 Main St"}}).ToModel()
+	require.NoError(t, err)
+	converted, err := model.ToProto()
+	require.NoError(t, err)
+	require.Nil(t, converted.Shipping)
+	require.Equal(t, "1 Main St", converted.Billing.Street)
+}
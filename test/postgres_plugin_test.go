@@ -3,25 +3,41 @@ package test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/brianvoe/gofakeit/v6"
 	. "github.com/catalystcommunity/protoc-gen-go-gorm/example/postgres"
+	"github.com/catalystcommunity/protoc-gen-go-gorm/example/postgres/catalog"
+	"github.com/catalystcommunity/protoc-gen-go-gorm/gormtypes"
 	"github.com/emirpasic/gods/sets/hashset"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/orlangure/gnomock"
 	postgres_preset "github.com/orlangure/gnomock/preset/postgres"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/genproto/googleapis/type/decimal"
+	"google.golang.org/genproto/googleapis/type/latlng"
+	"google.golang.org/genproto/googleapis/type/money"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 	"log"
 	"os"
+	"strings"
 	"testing"
+	"time"
 )
 
 var postgresContainer *gnomock.Container
@@ -60,7 +76,9 @@ func (s *PostgresPluginSuite) SetupSuite() {
 	)
 	postgresDb, err = gorm.Open(postgres.Open(dsn), &gorm.Config{Logger: logger})
 	require.NoError(s.T(), err)
-	err = postgresDb.AutoMigrate(&UserGormModel{}, &AddressGormModel{}, &CommentGormModel{})
+	err = MigrateEnums(context.Background(), postgresDb)
+	require.NoError(s.T(), err)
+	err = postgresDb.AutoMigrate(&UserGormModel{}, &AddressGormModel{}, &CommentGormModel{}, &catalog.SupplierGormModel{}, &ProductGormModel{}, &CouponGormModel{}, &InvoiceGormModel{}, &ProductHistoryGormModel{}, &InvoiceHistoryGormModel{}, &gormtypes.OutboxEvent{}, &StockLevelGormModel{}, &StockReservationGormModel{}, &StockCountGormModel{}, &StockCountHistoryGormModel{})
 	require.NoError(s.T(), err)
 }
 
@@ -167,9 +185,19 @@ func getPostgresAddress(t *testing.T) *Address {
 	err := gofakeit.Struct(&address)
 	require.NoError(t, err)
 	address.CompanyBlob = getPostgresCompany(t)
+	address.CompanyBlobs = getPostgresCompanys(t, gofakeit.Number(2, 5))
+	address.PrimaryTag = getPostgresTag(t)
+	address.Tags = []*Tag{getPostgresTag(t), getPostgresTag(t)}
 	return address
 }
 
+func getPostgresTag(t *testing.T) *Tag {
+	var tag *Tag
+	err := gofakeit.Struct(&tag)
+	require.NoError(t, err)
+	return tag
+}
+
 func getPostgresUserById(id string) (*UserGormModel, error) {
 	session := postgresDb.Session(&gorm.Session{})
 	var user *UserGormModel
@@ -482,3 +510,1011 @@ func (s *PostgresPluginSuite) TestDelete() {
 	require.NoError(s.T(), err)
 	require.Len(s.T(), fetchedModels, 0)
 }
+
+// TestDeleteReturning tests that deletes return the deleted models and protos
+func (s *PostgresPluginSuite) TestDeleteReturning() {
+	ctx := context.Background()
+	companies := getPostgresCompanys(s.T(), 3)
+	_, err := Upsert[*Company, *CompanyGormModel](ctx, postgresDb, companies)
+	require.NoError(s.T(), err)
+	// ids that don't exist aren't returned
+	deletedModels, err := Delete[*CompanyGormModel](ctx, postgresDb, []string{*companies[0].Id, uuid.New().String()})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), deletedModels, 1)
+	require.Equal(s.T(), *companies[0].Id, *deletedModels[0].Id)
+	require.Equal(s.T(), companies[0].Name, deletedModels[0].Name)
+	require.NotNil(s.T(), deletedModels[0].CreatedAt)
+	models, protos, err := DeleteCompanyGormModels(ctx, postgresDb, []string{*companies[1].Id, *companies[2].Id})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), models, 2)
+	require.Len(s.T(), protos, 2)
+	names := lo.Map(protos, func(company *Company, _ int) string { return company.Name })
+	require.ElementsMatch(s.T(), []string{companies[1].Name, companies[2].Name}, names)
+	fetched, err := GetByIds[*CompanyGormModel](ctx, postgresDb, lo.Map(companies, func(company *Company, _ int) string { return *company.Id }), nil)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), fetched)
+}
+
+// TestErrors tests that the generated functions return the typed errors of gormtypes.ClassifyError
+func (s *PostgresPluginSuite) TestErrors() {
+	ctx := context.Background()
+	_, err := Get[*CompanyGormModel](ctx, postgresDb, uuid.New().String(), nil)
+	require.ErrorIs(s.T(), err, gormtypes.ErrNotFound)
+	require.Equal(s.T(), codes.NotFound, status.Code(GormErrorToStatus(err)))
+	company := getPostgresCompany(s.T())
+	_, err = Upsert[*Company, *CompanyGormModel](ctx, postgresDb, []*Company{company})
+	require.NoError(s.T(), err)
+	model, err := Get[*CompanyGormModel](ctx, postgresDb, *company.Id, nil)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), *company.Id, *model.Id)
+	// a level with the id of another level but another sku doesn't conflict on the conflict columns, but on the id
+	level := &StockLevel{Warehouse: "north", Sku: gofakeit.UUID()}
+	levels := StockLevelProtos{level}
+	_, err = levels.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	levels = StockLevelProtos{{Id: level.Id, Warehouse: "north", Sku: gofakeit.UUID()}}
+	_, err = levels.Upsert(ctx, postgresDb)
+	var unique *gormtypes.UniqueViolationError
+	require.ErrorAs(s.T(), err, &unique)
+	require.NotEmpty(s.T(), unique.Constraint)
+	require.Equal(s.T(), []string{"id"}, unique.Fields)
+	require.Equal(s.T(), codes.AlreadyExists, status.Code(GormErrorToStatus(err)))
+	// a user of a company that doesn't exist
+	user := getPostgresUser(s.T())
+	user.CompanyId = lo.ToPtr(uuid.New().String())
+	_, err = Upsert[*User, *UserGormModel](ctx, postgresDb, []*User{user})
+	require.ErrorIs(s.T(), err, gormtypes.ErrForeignKeyViolation)
+	require.Equal(s.T(), codes.FailedPrecondition, status.Code(GormErrorToStatus(err)))
+	// a coupon whose code is too short
+	coupons := CouponProtos{{Code: "ABC", PercentOff: 15, Tier: EnumOne_Two, Category: EnumOne_Nine, Currency: "EUR"}}
+	_, err = coupons.Upsert(ctx, postgresDb)
+	var check *gormtypes.CheckViolationError
+	require.ErrorAs(s.T(), err, &check)
+	require.NotEmpty(s.T(), check.Constraint)
+	// a level whose created at isn't a timestamp
+	levels = StockLevelProtos{{Warehouse: "north", Sku: gofakeit.UUID(), CreatedAt: "yesterday"}}
+	_, err = levels.Upsert(ctx, postgresDb)
+	require.ErrorIs(s.T(), err, gormtypes.ErrConversion)
+	require.Equal(s.T(), codes.InvalidArgument, status.Code(GormErrorToStatus(err)))
+}
+
+// TestEnumStrategies tests that each enum storage strategy round trips and stores the expected values
+func (s *PostgresPluginSuite) TestEnumStrategies() {
+	user := getPostgresUser(s.T())
+	user.NativeEnum = EnumOne_Nine
+	user.NativeEnumList = []EnumOne{EnumOne_One, EnumOne_Nine}
+	user.LookupEnum = EnumOne_Three
+	user.StrictEnum = EnumOne_Nine
+	_, err := Upsert[*User, *UserGormModel](context.Background(), postgresDb, []*User{user})
+	require.NoError(s.T(), err)
+	// assert stored values
+	model, err := getPostgresUserById(*user.Id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "nine", model.NativeEnum)
+	require.Equal(s.T(), []string{"One", "nine"}, []string(model.NativeEnumList))
+	require.Equal(s.T(), int(EnumOne_Three), model.LookupEnum)
+	require.NotNil(s.T(), model.LookupEnumLookup)
+	require.Equal(s.T(), "Three", model.LookupEnumLookup.Name)
+	require.Equal(s.T(), "nine", model.StrictEnum)
+	// assert round trip
+	fetchedUser, err := model.ToProto()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), user.NativeEnum, fetchedUser.NativeEnum)
+	require.Equal(s.T(), user.NativeEnumList, fetchedUser.NativeEnumList)
+	require.Equal(s.T(), user.LookupEnum, fetchedUser.LookupEnum)
+	require.Equal(s.T(), user.StrictEnum, fetchedUser.StrictEnum)
+	// a lookup table value that doesn't exist violates the foreign key
+	user.LookupEnum = EnumOne(100)
+	_, err = Upsert[*User, *UserGormModel](context.Background(), postgresDb, []*User{user})
+	require.Error(s.T(), err)
+	// unknown stored values map to 0, unless the field is strict
+	model.NativeEnum = "unknown"
+	fetchedUser, err = model.ToProto()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), EnumOne_Default, fetchedUser.NativeEnum)
+	model.StrictEnum = "unknown"
+	_, err = model.ToProto()
+	require.Error(s.T(), err)
+}
+
+// TestJsonb tests that singular and repeated jsonb fields, including non ormable messages, round trip
+func (s *PostgresPluginSuite) TestJsonb() {
+	user := getPostgresUser(s.T())
+	_, err := Upsert[*User, *UserGormModel](context.Background(), postgresDb, []*User{user})
+	require.NoError(s.T(), err)
+	address := getPostgresAddress(s.T())
+	address.UserId = user.Id
+	_, err = Upsert[*Address, *AddressGormModel](context.Background(), postgresDb, []*Address{address})
+	require.NoError(s.T(), err)
+	// get and assert
+	fetchedModels, err := GetByIds[*AddressGormModel](context.Background(), postgresDb, []string{*address.Id}, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), fetchedModels, 1)
+	require.Len(s.T(), fetchedModels[0].CompanyBlobs, len(address.CompanyBlobs))
+	require.Len(s.T(), fetchedModels[0].Tags, len(address.Tags))
+	fetchedAddress, err := fetchedModels[0].ToProto()
+	require.NoError(s.T(), err)
+	assertPostgresProtosEquality(s.T(), address, fetchedAddress,
+		protocmp.IgnoreFields(&Address{}, "created_at", "updated_at"),
+	)
+}
+
+// TestJsonbProtojson tests that jsonb fields are stored as protojson with their jsonb_options, and that well known types
+// in them round trip
+func (s *PostgresPluginSuite) TestJsonbProtojson() {
+	user := getPostgresUser(s.T())
+	_, err := Upsert[*User, *UserGormModel](context.Background(), postgresDb, []*User{user})
+	require.NoError(s.T(), err)
+	address := getPostgresAddress(s.T())
+	address.UserId = user.Id
+	createdAt := timestamppb.New(time.Date(2023, 4, 5, 6, 7, 8, 0, time.UTC))
+	address.CompanyBlob = &Company{CreatedAt: createdAt}
+	address.CompanyBlobs = []*Company{{Name: "acme", CreatedAt: createdAt}}
+	_, err = Upsert[*Address, *AddressGormModel](context.Background(), postgresDb, []*Address{address})
+	require.NoError(s.T(), err)
+	// company_blob uses proto names and emits unpopulated fields, company_blobs uses the protojson defaults
+	var stored struct {
+		CompanyBlob  string
+		CompanyBlobs string
+	}
+	err = postgresDb.Raw("SELECT company_blob::text AS company_blob, company_blobs::text AS company_blobs FROM addresses WHERE id = ?",
+		*address.Id).Scan(&stored).Error
+	require.NoError(s.T(), err)
+	var companyBlob map[string]interface{}
+	require.NoError(s.T(), json.Unmarshal([]byte(stored.CompanyBlob), &companyBlob))
+	require.Equal(s.T(), "2023-04-05T06:07:08Z", companyBlob["created_at"])
+	require.Contains(s.T(), companyBlob, "name")
+	var companyBlobs []map[string]interface{}
+	require.NoError(s.T(), json.Unmarshal([]byte(stored.CompanyBlobs), &companyBlobs))
+	require.Len(s.T(), companyBlobs, 1)
+	require.Equal(s.T(), "2023-04-05T06:07:08Z", companyBlobs[0]["createdAt"])
+	require.NotContains(s.T(), companyBlobs[0], "updatedAt")
+	// get and assert
+	fetchedModels, err := GetByIds[*AddressGormModel](context.Background(), postgresDb, []string{*address.Id}, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), fetchedModels, 1)
+	fetchedAddress, err := fetchedModels[0].ToProto()
+	require.NoError(s.T(), err)
+	assertPostgresProtosEquality(s.T(), address, fetchedAddress,
+		protocmp.IgnoreFields(&Address{}, "created_at", "updated_at"),
+	)
+}
+
+// TestEmbedded tests that embedded messages are stored in prefixed columns on the parent table and round trip
+func (s *PostgresPluginSuite) TestEmbedded() {
+	require.True(s.T(), postgresDb.Migrator().HasColumn(&UserGormModel{}, "shipping_street"))
+	require.True(s.T(), postgresDb.Migrator().HasColumn(&UserGormModel{}, "bill_to_postal_code"))
+	user := getPostgresUser(s.T())
+	err := gofakeit.Struct(&user.Shipping)
+	require.NoError(s.T(), err)
+	err = gofakeit.Struct(&user.Billing)
+	require.NoError(s.T(), err)
+	_, err = Upsert[*User, *UserGormModel](context.Background(), postgresDb, []*User{user})
+	require.NoError(s.T(), err)
+	// assert
+	model, err := getPostgresUserById(*user.Id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), user.Shipping.Street, model.Shipping.Street)
+	require.Equal(s.T(), user.Billing.Country, model.Billing.Country)
+	fetchedUser, err := model.ToProto()
+	require.NoError(s.T(), err)
+	assertPostgresProtosEquality(s.T(), user.Shipping, fetchedUser.Shipping)
+	assertPostgresProtosEquality(s.T(), user.Billing, fetchedUser.Billing)
+	// an unset embedded message is read back unset
+	user = getPostgresUser(s.T())
+	user.Shipping = nil
+	_, err = Upsert[*User, *UserGormModel](context.Background(), postgresDb, []*User{user})
+	require.NoError(s.T(), err)
+	model, err = getPostgresUserById(*user.Id)
+	require.NoError(s.T(), err)
+	fetchedUser, err = model.ToProto()
+	require.NoError(s.T(), err)
+	require.Nil(s.T(), fetchedUser.Shipping)
+}
+
+// TestCustomType tests that custom types are converted with their converters, and that converter errors are returned
+func (s *PostgresPluginSuite) TestCustomType() {
+	user := getPostgresUser(s.T())
+	_, err := Upsert[*User, *UserGormModel](context.Background(), postgresDb, []*User{user})
+	require.NoError(s.T(), err)
+	// assert
+	model, err := getPostgresUserById(*user.Id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), user.IpAddress, model.IpAddress.String())
+	fetchedUser, err := model.ToProto()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), user.IpAddress, fetchedUser.IpAddress)
+	// converter errors are returned
+	user.IpAddress = "not an ip address"
+	_, err = user.ToModel()
+	require.Error(s.T(), err)
+}
+
+// TestGoogleTypes tests that google.type fields are stored in their columns and read back unchanged
+func (s *PostgresPluginSuite) TestGoogleTypes() {
+	user := getPostgresUser(s.T())
+	user.Birthday = &date.Date{Year: 1987, Month: 6, Day: 21}
+	user.WakeUpTime = &timeofday.TimeOfDay{Hours: 6, Minutes: 45, Seconds: 30, Nanos: 250000000}
+	user.Balance = &money.Money{CurrencyCode: "USD", Units: -12, Nanos: -340000000}
+	user.InterestRate = &decimal.Decimal{Value: "0.0425"}
+	user.Location = &latlng.LatLng{Latitude: 40.7608, Longitude: -111.891}
+	_, err := Upsert[*User, *UserGormModel](context.Background(), postgresDb, []*User{user})
+	require.NoError(s.T(), err)
+	// assert
+	model, err := getPostgresUserById(*user.Id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "-12.340000000", *model.BalanceAmount)
+	require.Equal(s.T(), "USD", *model.BalanceCurrency)
+	require.Equal(s.T(), 40.7608, *model.LocationLatitude)
+	fetchedUser, err := model.ToProto()
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), cmp.Diff(user.Birthday, fetchedUser.Birthday, protocmp.Transform()))
+	require.Empty(s.T(), cmp.Diff(user.WakeUpTime, fetchedUser.WakeUpTime, protocmp.Transform()))
+	require.Empty(s.T(), cmp.Diff(user.Balance, fetchedUser.Balance, protocmp.Transform()))
+	require.Empty(s.T(), cmp.Diff(user.InterestRate, fetchedUser.InterestRate, protocmp.Transform()))
+	require.Empty(s.T(), cmp.Diff(user.Location, fetchedUser.Location, protocmp.Transform()))
+	// partial dates can't be stored
+	user.Birthday = &date.Date{Month: 6, Day: 21}
+	_, err = user.ToModel()
+	require.Error(s.T(), err)
+}
+
+// TestUserTemplates tests that the per message and per file templates in example/templates are rendered
+func (s *PostgresPluginSuite) TestUserTemplates() {
+	require.Equal(s.T(), (&UserGormModel{}).TableName(), UserGormModelTable)
+	require.Equal(s.T(), []string{"users", "companies", "addresses", "comments", "profiles", "products"}, TableNames())
+}
+
+// TestMultipleFiles tests that messages from another file in the same go package share the package's generics, enum
+// helpers and embedded models
+func (s *PostgresPluginSuite) TestMultipleFiles() {
+	product := &Product{}
+	require.NoError(s.T(), gofakeit.Struct(product))
+	product.Category = EnumOne_Two
+	product.Warehouse = &PostalAddress{Street: gofakeit.Street(), City: gofakeit.City(), PostalCode: gofakeit.Zip()}
+	models, err := Upsert[*Product, *ProductGormModel](context.Background(), postgresDb, []*Product{product})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), models, 1)
+	require.Equal(s.T(), "Two", models[0].Category)
+	// assert
+	fetched, err := GetByIds[*ProductGormModel](context.Background(), postgresDb, []string{*models[0].Id}, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), fetched, 1)
+	fetchedProduct, err := fetched[0].ToProto()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), product.Name, fetchedProduct.Name)
+	require.Equal(s.T(), product.Category, fetchedProduct.Category)
+	require.Empty(s.T(), cmp.Diff(product.Warehouse, fetchedProduct.Warehouse, protocmp.Transform()))
+}
+
+// TestSearch tests that products are searched by their name and description, matches of the name ranking first
+func (s *PostgresPluginSuite) TestSearch() {
+	// the search_vector column and its index already exist, the statements can be run again
+	for _, statement := range ProductSearchDDL {
+		require.NoError(s.T(), postgresDb.Exec(statement).Error)
+	}
+	term := "zq" + strings.ReplaceAll(uuid.NewString(), "-", "")[:12]
+	inDescription := &Product{Name: gofakeit.Word(), Description: "a description mentioning " + term}
+	inName := &Product{Name: term, Description: gofakeit.Sentence(8)}
+	other := &Product{Name: gofakeit.Word(), Description: gofakeit.Sentence(8)}
+	_, err := Upsert[*Product, *ProductGormModel](context.Background(), postgresDb, []*Product{inDescription, inName, other})
+	require.NoError(s.T(), err)
+	// assert
+	found, err := SearchProductGormModels(context.Background(), postgresDb, term, 10)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), found, 2)
+	require.Equal(s.T(), *inName.Id, *found[0].Id)
+	require.Equal(s.T(), *inDescription.Id, *found[1].Id)
+	found, err = SearchProductGormModels(context.Background(), postgresDb, term, 1)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), found, 1)
+}
+
+// TestIndexes tests that AutoMigrate creates the indexes of fields from the model's tags, and that the indexes DDL
+// creates the partial and expression indexes next to them
+func (s *PostgresPluginSuite) TestIndexes() {
+	require.True(s.T(), postgresDb.Migrator().HasIndex(&ProductGormModel{}, "idx_products_supplier_id_supplier_tier"))
+	require.True(s.T(), postgresDb.Migrator().HasIndex(&ProductGormModel{}, "idx_products_fallback_tiers"))
+	require.False(s.T(), postgresDb.Migrator().HasIndex(&ProductGormModel{}, "idx_products_company_id"))
+	for _, statement := range ProductIndexesDDL {
+		require.NoError(s.T(), postgresDb.Exec(statement).Error)
+	}
+	// assert
+	for _, statement := range ProductIndexesDDL {
+		require.True(s.T(), postgresDb.Migrator().HasIndex(&ProductGormModel{}, strings.Fields(statement)[5]), statement)
+	}
+	var definition string
+	require.NoError(s.T(), postgresDb.Raw("SELECT indexdef FROM pg_indexes WHERE indexname = ?", "idx_products_company_id").Scan(&definition).Error)
+	require.Contains(s.T(), definition, "WHERE (supplier_id IS NULL)")
+}
+
+// TestView tests that view backed models are read from their view, which the view DDL creates from the tables it
+// projects
+func (s *PostgresPluginSuite) TestView() {
+	for _, statement := range ProductSummaryViewDDL {
+		require.NoError(s.T(), postgresDb.Exec(statement).Error)
+	}
+	company := getPostgresCompany(s.T())
+	_, err := Upsert[*Company, *CompanyGormModel](context.Background(), postgresDb, []*Company{company})
+	require.NoError(s.T(), err)
+	product := &Product{Name: gofakeit.Word(), CompanyId: company.Id, SupplierTier: catalog.SupplierTier_SUPPLIER_TIER_PREFERRED}
+	_, err = Upsert[*Product, *ProductGormModel](context.Background(), postgresDb, []*Product{product})
+	require.NoError(s.T(), err)
+	// assert
+	summaries, err := NewProductSummaryGormRepository(postgresDb).GetByIds(context.Background(), []string{*product.Id})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), summaries, 1)
+	require.Equal(s.T(), product.Name, summaries[0].Name)
+	require.Equal(s.T(), product.SupplierTier, summaries[0].SupplierTier)
+	require.Equal(s.T(), company.Name, summaries[0].CompanyName)
+	models, err := List[*ProductSummaryGormModel](context.Background(), postgresDb, -1, 0, "name", nil)
+	require.NoError(s.T(), err)
+	require.True(s.T(), lo.ContainsBy(models, func(model *ProductSummaryGormModel) bool { return *model.Id == *product.Id }))
+}
+
+// TestCrossPackageReferences tests that associations and enums referencing messages in another file or go package are
+// converted and preloaded
+func (s *PostgresPluginSuite) TestCrossPackageReferences() {
+	company := getPostgresCompany(s.T())
+	_, err := Upsert[*Company, *CompanyGormModel](context.Background(), postgresDb, []*Company{company})
+	require.NoError(s.T(), err)
+	supplier := &catalog.Supplier{Name: gofakeit.Company()}
+	_, err = catalog.Upsert[*catalog.Supplier, *catalog.SupplierGormModel](context.Background(), postgresDb, []*catalog.Supplier{supplier})
+	require.NoError(s.T(), err)
+	product := &Product{}
+	require.NoError(s.T(), gofakeit.Struct(product))
+	product.CompanyId = company.Id
+	product.SupplierId = supplier.Id
+	product.SupplierTier = catalog.SupplierTier_SUPPLIER_TIER_PREFERRED
+	product.FallbackTiers = []catalog.SupplierTier{catalog.SupplierTier_SUPPLIER_TIER_BACKUP}
+	_, err = Upsert[*Product, *ProductGormModel](context.Background(), postgresDb, []*Product{product})
+	require.NoError(s.T(), err)
+	// assert
+	fetched, err := GetByIds[*ProductGormModel](context.Background(), postgresDb, []string{*product.Id}, map[string][]interface{}{"Company": nil, "Supplier": nil})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), fetched, 1)
+	require.Equal(s.T(), pq.StringArray{"SUPPLIER_TIER_BACKUP"}, fetched[0].FallbackTiers)
+	fetchedProduct, err := fetched[0].ToProto()
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), company.Name, fetchedProduct.Company.Name)
+	require.Equal(s.T(), supplier.Name, fetchedProduct.Supplier.Name)
+	require.Equal(s.T(), product.SupplierTier, fetchedProduct.SupplierTier)
+	require.Equal(s.T(), product.FallbackTiers, fetchedProduct.FallbackTiers)
+}
+
+// TestRepository tests the gorm repository through its interface
+func (s *PostgresPluginSuite) TestRepository() {
+	var repository UserRepository = NewUserGormRepository(postgresDb)
+	user := getPostgresUser(s.T())
+	user.Id = nil
+	upserted, err := repository.Upsert(context.Background(), UserProtos{user})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), upserted, 1)
+	require.NotNil(s.T(), upserted[0].Id)
+	// associations
+	profiles := getPostgresProfiles(s.T(), 2)
+	_, err = NewProfileGormRepository(postgresDb).Upsert(context.Background(), profiles)
+	require.NoError(s.T(), err)
+	associations := &ManyToManyAssociations{}
+	for _, profile := range profiles {
+		associations.AddAssociation(*user.Id, *profile.Id)
+	}
+	require.NoError(s.T(), repository.AssociateProfiles(context.Background(), associations))
+	fetched, err := repository.GetByIds(context.Background(), []string{*user.Id}, "Profiles")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), fetched, 1)
+	require.Len(s.T(), fetched[0].Profiles, 2)
+	listed, err := repository.List(context.Background(), 1, 0, "created_at desc")
+	require.NoError(s.T(), err)
+	require.Len(s.T(), listed, 1)
+	// delete
+	deleted, err := repository.Delete(context.Background(), []string{*user.Id})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), deleted, 1)
+	require.Equal(s.T(), *user.Id, *deleted[0].Id)
+	fetched, err = repository.GetByIds(context.Background(), []string{*user.Id})
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), fetched)
+}
+
+// TestService tests the gorm server generated for the annotated UserService
+func (s *PostgresPluginSuite) TestService() {
+	ctx := context.Background()
+	server := NewUserServiceGormServer(postgresDb)
+	user := getPostgresUser(s.T())
+	user.Id = nil
+	created, err := server.CreateUser(ctx, &CreateUserRequest{User: user})
+	require.NoError(s.T(), err)
+	require.NotNil(s.T(), created.Id)
+	require.NotEmpty(s.T(), created.CreatedAt)
+	_, err = server.CreateUser(ctx, &CreateUserRequest{User: user})
+	require.Equal(s.T(), codes.AlreadyExists, status.Code(err))
+	// get
+	fetched, err := server.GetUser(ctx, &GetUserRequest{Id: *created.Id})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), user.AString, fetched.AString)
+	_, err = server.GetUser(ctx, &GetUserRequest{Id: uuid.New().String()})
+	require.Equal(s.T(), codes.NotFound, status.Code(err))
+	_, err = server.GetUser(ctx, &GetUserRequest{Id: "not a uuid"})
+	require.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	// update only the masked fields
+	update := &User{Id: created.Id, AString: "updated", AnInt32: created.AnInt32 + 1}
+	updated, err := server.UpdateUser(ctx, &UpdateUserRequest{User: update, UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"a_string"}}})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), "updated", updated.AString)
+	require.Equal(s.T(), created.AnInt32, updated.AnInt32)
+	require.Equal(s.T(), created.CreatedAt, updated.CreatedAt)
+	_, err = server.UpdateUser(ctx, &UpdateUserRequest{User: &User{Id: lo.ToPtr(uuid.New().String())}})
+	require.Equal(s.T(), codes.NotFound, status.Code(err))
+	// list pages
+	for i := 0; i < 2; i++ {
+		other := getPostgresUser(s.T())
+		other.Id = nil
+		_, err = server.CreateUser(ctx, &CreateUserRequest{User: other})
+		require.NoError(s.T(), err)
+	}
+	page, err := server.ListUsers(ctx, &ListUsersRequest{PageSize: 1, OrderBy: "created_at desc"})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), page.Users, 1)
+	require.NotEmpty(s.T(), page.NextPageToken)
+	nextPage, err := server.ListUsers(ctx, &ListUsersRequest{PageSize: 1, PageToken: page.NextPageToken, OrderBy: "created_at desc"})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), nextPage.Users, 1)
+	require.NotEqual(s.T(), *page.Users[0].Id, *nextPage.Users[0].Id)
+	_, err = server.ListUsers(ctx, &ListUsersRequest{OrderBy: "a_string; drop table users"})
+	require.Equal(s.T(), codes.InvalidArgument, status.Code(err))
+	// delete
+	_, err = server.DeleteUser(ctx, &DeleteUserRequest{Id: *created.Id})
+	require.NoError(s.T(), err)
+	_, err = server.GetUser(ctx, &GetUserRequest{Id: *created.Id})
+	require.Equal(s.T(), codes.NotFound, status.Code(err))
+	// rpcs that aren't standard are left unimplemented
+	_, err = server.DeactivateUser(ctx, &GetUserRequest{Id: *created.Id})
+	require.Equal(s.T(), codes.Unimplemented, status.Code(err))
+}
+
+func (s *PostgresPluginSuite) TestCheckConstraints() {
+	ctx := context.Background()
+	valid := func() *Coupon {
+		return &Coupon{
+			Code:          "SPRING24",
+			PercentOff:    15,
+			Tier:          EnumOne_Two,
+			Category:      EnumOne_Nine,
+			Regions:       []string{"us", "eu"},
+			Currency:      "EUR",
+			MinOrderTotal: 10,
+		}
+	}
+	coupons := CouponProtos{valid()}
+	_, err := coupons.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	// the model's check tags and the ddl name the constraints the same
+	for _, statement := range CouponCheckConstraintsDDL {
+		require.True(s.T(), postgresDb.Migrator().HasConstraint(&CouponGormModel{}, strings.Fields(statement)[5]), statement)
+	}
+	invalid := map[string]func(coupon *Coupon){
+		"too short":          func(coupon *Coupon) { coupon.Code = "ABC" },
+		"pattern":            func(coupon *Coupon) { coupon.Code = "spring24" },
+		"description length": func(coupon *Coupon) { coupon.Description = strings.Repeat("a", 141) },
+		"percent off":        func(coupon *Coupon) { coupon.PercentOff = 0 },
+		"max redemptions":    func(coupon *Coupon) { coupon.MaxRedemptions = lo.ToPtr(int64(0)) },
+		"min order total":    func(coupon *Coupon) { coupon.MinOrderTotal = -1 },
+		"undefined enum":     func(coupon *Coupon) { coupon.Tier = EnumOne(42) },
+		"not in":             func(coupon *Coupon) { coupon.Category = EnumOne_Default },
+		"max items":          func(coupon *Coupon) { coupon.Regions = []string{"us", "eu", "uk", "ca"} },
+		"in":                 func(coupon *Coupon) { coupon.Currency = "JPY" },
+	}
+	for name, invalidate := range invalid {
+		coupon := valid()
+		invalidate(coupon)
+		coupons = CouponProtos{coupon}
+		_, err = coupons.Upsert(ctx, postgresDb)
+		require.Equal(s.T(), codes.InvalidArgument, status.Code(GormErrorToStatus(err)), name)
+	}
+	// ignore_empty allows an empty description, and an unset optional field is null
+	coupon := valid()
+	coupon.Description = ""
+	coupon.MaxRedemptions = nil
+	coupons = CouponProtos{coupon}
+	_, err = coupons.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+}
+
+func (s *PostgresPluginSuite) TestTenants() {
+	tenantA := gormtypes.WithTenant(context.Background(), uuid.New().String())
+	tenantB := gormtypes.WithTenant(context.Background(), uuid.New().String())
+	invoices := InvoiceProtos{{Number: "A-1", TotalCents: 100}, {Number: "A-2", TotalCents: 200}}
+	_, err := invoices.Upsert(tenantA, postgresDb)
+	require.NoError(s.T(), err)
+	other := InvoiceProtos{{Number: "B-1", TotalCents: 300}}
+	_, err = other.Upsert(tenantB, postgresDb)
+	require.NoError(s.T(), err)
+	tenant, _ := gormtypes.TenantFromContext(tenantA)
+	require.Equal(s.T(), tenant, invoices[0].TenantId)
+	// queries without a tenant are refused
+	listed := InvoiceProtos{}
+	require.ErrorIs(s.T(), listed.List(context.Background(), postgresDb, 10, 0, nil), gormtypes.ErrMissingTenant)
+	// reads only see the context's tenant
+	require.NoError(s.T(), listed.List(tenantA, postgresDb, 10, 0, nil))
+	require.Len(s.T(), listed, 2)
+	require.NoError(s.T(), listed.GetByIds(tenantB, postgresDb, []string{*invoices[0].Id, *other[0].Id}))
+	require.Len(s.T(), listed, 1)
+	require.Equal(s.T(), "B-1", listed[0].Number)
+	models, err := List[*InvoiceGormModel](tenantB, postgresDb, 10, 0, "", nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), models, 1)
+	models, err = GetByIds[*InvoiceGormModel](tenantB, postgresDb, []string{*invoices[0].Id}, nil)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), models)
+	// writes can't touch rows of another tenant
+	stolen := InvoiceProtos{{Id: invoices[0].Id, Number: "stolen"}}
+	_, err = stolen.Upsert(tenantB, postgresDb)
+	require.ErrorIs(s.T(), err, gormtypes.ErrTenantMismatch)
+	// a batch holding a row of another tenant is rolled back as a whole
+	batch := InvoiceProtos{{Id: invoices[0].Id, Number: "stolen"}, {Number: "B-2"}}
+	_, err = batch.Upsert(tenantB, postgresDb)
+	require.ErrorIs(s.T(), err, gormtypes.ErrTenantMismatch)
+	require.NoError(s.T(), listed.GetByIds(tenantB, postgresDb, []string{*batch[1].Id}))
+	require.Empty(s.T(), listed)
+	_, err = Upsert[*Invoice, *InvoiceGormModel](tenantB, postgresDb, []*Invoice{{Id: invoices[0].Id, Number: "stolen"}})
+	require.ErrorIs(s.T(), err, gormtypes.ErrTenantMismatch)
+	mismatched := InvoiceProtos{{Number: "A-3", TenantId: invoices[0].TenantId}}
+	_, err = mismatched.Upsert(tenantB, postgresDb)
+	require.ErrorIs(s.T(), err, gormtypes.ErrTenantMismatch)
+	_, _, err = DeleteInvoiceGormModels(tenantB, postgresDb, []string{*invoices[0].Id})
+	require.NoError(s.T(), err)
+	require.NoError(s.T(), listed.GetByIds(tenantA, postgresDb, []string{*invoices[0].Id}))
+	require.Len(s.T(), listed, 1)
+	require.Equal(s.T(), "A-1", listed[0].Number)
+	require.Equal(s.T(), codes.PermissionDenied, status.Code(GormErrorToStatus(gormtypes.ErrTenantMismatch)))
+	// the fake repository scopes the same way
+	fake := NewInvoiceFakeRepository(NewFakeStore())
+	_, err = fake.Upsert(tenantA, InvoiceProtos{{Id: invoices[0].Id, Number: "A-1"}})
+	require.NoError(s.T(), err)
+	_, err = fake.Upsert(tenantB, InvoiceProtos{{Id: invoices[0].Id, Number: "stolen"}})
+	require.ErrorIs(s.T(), err, gormtypes.ErrTenantMismatch)
+	fetched, err := fake.GetByIds(tenantB, []string{*invoices[0].Id})
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), fetched)
+	_, err = fake.List(context.Background(), 10, 0, nil)
+	require.ErrorIs(s.T(), err, gormtypes.ErrMissingTenant)
+}
+
+// TestHistory tests that the generated and generic upserts and deletes record the changes of messages with the history
+// option in the same transaction, with the actor of the context
+func (s *PostgresPluginSuite) TestHistory() {
+	alice := gormtypes.WithActor(context.Background(), "alice")
+	bob := gormtypes.WithActor(context.Background(), "bob")
+	products := ProductProtos{{Name: "first", Category: EnumOne_Two}}
+	_, err := products.Upsert(alice, postgresDb)
+	require.NoError(s.T(), err)
+	id := *products[0].Id
+	_, err = Upsert[*Product, *ProductGormModel](bob, postgresDb, []*Product{{Id: &id, Name: "second", Category: EnumOne_Two}})
+	require.NoError(s.T(), err)
+	// a failed write records nothing
+	err = (&ProductGormModel{}).RecordHistory(alice, postgresDb, []string{id}, func(tx *gorm.DB) error {
+		require.NoError(s.T(), tx.Model(&ProductGormModel{}).Where("id = ?", id).Update("name", "third").Error)
+		return fmt.Errorf("rolled back")
+	})
+	require.Error(s.T(), err)
+	// deleting rows that don't exist records nothing
+	_, _, err = DeleteProductGormModels(alice, postgresDb, []string{id, uuid.New().String()})
+	require.NoError(s.T(), err)
+	// assert
+	entries, err := ProductHistory(context.Background(), postgresDb, id)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), entries, 3)
+	require.Equal(s.T(), []string{gormtypes.HistoryCreate, gormtypes.HistoryUpdate, gormtypes.HistoryDelete},
+		lo.Map(entries, func(entry *ProductHistoryEntry, _ int) string { return entry.Operation }))
+	require.Equal(s.T(), []string{"alice", "bob", "alice"},
+		lo.Map(entries, func(entry *ProductHistoryEntry, _ int) string { return entry.Actor }))
+	require.Nil(s.T(), entries[0].Before)
+	require.Equal(s.T(), "first", entries[0].After.Name)
+	require.Equal(s.T(), EnumOne_Two, entries[0].After.Category)
+	require.Equal(s.T(), "first", entries[1].Before.Name)
+	require.Equal(s.T(), "second", entries[1].After.Name)
+	require.Equal(s.T(), "second", entries[2].Before.Name)
+	require.Nil(s.T(), entries[2].After)
+	require.False(s.T(), entries[1].ChangedAt.Before(entries[0].ChangedAt))
+	// the generic delete records too, without an actor when the context has none
+	models, err := Upsert[*Product, *ProductGormModel](context.Background(), postgresDb, []*Product{{Name: "generic"}})
+	require.NoError(s.T(), err)
+	_, err = Delete[*ProductGormModel](context.Background(), postgresDb, []string{*models[0].Id})
+	require.NoError(s.T(), err)
+	entries, err = ProductHistory(context.Background(), postgresDb, *models[0].Id)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), entries, 2)
+	require.Equal(s.T(), gormtypes.HistoryDelete, entries[1].Operation)
+	require.Empty(s.T(), entries[1].Actor)
+	// the history of messages with a tenant column is scoped to the context's tenant
+	tenantA := gormtypes.WithTenant(alice, uuid.New().String())
+	tenantB := gormtypes.WithTenant(bob, uuid.New().String())
+	invoices := InvoiceProtos{{Number: "H-1", TotalCents: 100}}
+	_, err = invoices.Upsert(tenantA, postgresDb)
+	require.NoError(s.T(), err)
+	_, err = (&InvoiceProtos{{Id: invoices[0].Id, Number: "stolen"}}).Upsert(tenantB, postgresDb)
+	require.ErrorIs(s.T(), err, gormtypes.ErrTenantMismatch)
+	invoiceEntries, err := InvoiceHistory(tenantA, postgresDb, *invoices[0].Id)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), invoiceEntries, 1)
+	require.Equal(s.T(), "H-1", invoiceEntries[0].After.Number)
+	invoiceEntries, err = InvoiceHistory(tenantB, postgresDb, *invoices[0].Id)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), invoiceEntries)
+	_, err = InvoiceHistory(context.Background(), postgresDb, *invoices[0].Id)
+	require.ErrorIs(s.T(), err, gormtypes.ErrMissingTenant)
+}
+
+// TestOutbox tests that the generated and generic upserts and deletes of messages with the outbox option insert outbox
+// events in the same transaction, and that relays publish them in order, skipping events claimed by another relay
+func (s *PostgresPluginSuite) TestOutbox() {
+	ctx := context.Background()
+	// other tests insert events too
+	require.NoError(s.T(), postgresDb.Where("1 = 1").Delete(&gormtypes.OutboxEvent{}).Error)
+	coupons := CouponProtos{{Code: "OUTBOX1", PercentOff: 10, Tier: EnumOne_Two, Category: EnumOne_Two, Currency: "USD"}}
+	_, err := coupons.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	id := *coupons[0].Id
+	coupons[0].PercentOff = 20
+	_, err = Upsert[*Coupon, *CouponGormModel](ctx, postgresDb, []*Coupon(coupons))
+	require.NoError(s.T(), err)
+	// upserting the same coupon again inserts nothing
+	_, err = coupons.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	// a failed write inserts nothing
+	_, err = Upsert[*Coupon, *CouponGormModel](ctx, postgresDb, []*Coupon{{Code: "invalid", PercentOff: 10, Category: EnumOne_Two, Currency: "USD"}})
+	require.Error(s.T(), err)
+	// deleting rows that don't exist inserts nothing
+	_, _, err = DeleteCouponGormModels(ctx, postgresDb, []string{id, uuid.New().String()})
+	require.NoError(s.T(), err)
+	// assert
+	published := []*gormtypes.OutboxEvent{}
+	publish := func(ctx context.Context, events []*gormtypes.OutboxEvent) error {
+		published = append(published, events...)
+		return nil
+	}
+	failing := func(ctx context.Context, events []*gormtypes.OutboxEvent) error {
+		return fmt.Errorf("broker unavailable")
+	}
+	_, err = gormtypes.RelayOutbox(ctx, postgresDb, 10, failing)
+	require.Error(s.T(), err)
+	relayed, err := gormtypes.RelayOutbox(ctx, postgresDb, 2, publish)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 2, relayed)
+	relayed, err = gormtypes.RelayOutbox(ctx, postgresDb, 2, publish)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, relayed)
+	relayed, err = gormtypes.RelayOutbox(ctx, postgresDb, 2, publish)
+	require.NoError(s.T(), err)
+	require.Zero(s.T(), relayed)
+	require.Equal(s.T(), []string{gormtypes.OutboxCreate, gormtypes.OutboxUpdate, gormtypes.OutboxDelete},
+		lo.Map(published, func(event *gormtypes.OutboxEvent, _ int) string { return event.Operation }))
+	percentOffs := []int32{}
+	for _, event := range published {
+		require.Equal(s.T(), "example.postgres.Coupon", event.MessageName)
+		require.Equal(s.T(), id, event.RecordId)
+		message, err := event.Message()
+		require.NoError(s.T(), err)
+		percentOffs = append(percentOffs, message.(*Coupon).PercentOff)
+	}
+	require.Equal(s.T(), []int32{10, 20, 20}, percentOffs)
+	// events claimed by another relay's transaction are skipped
+	tenant := gormtypes.WithTenant(ctx, uuid.New().String())
+	_, err = (&InvoiceProtos{{Number: "O-1"}, {Number: "O-2"}}).Upsert(tenant, postgresDb)
+	require.NoError(s.T(), err)
+	err = postgresDb.Transaction(func(tx *gorm.DB) error {
+		claimed, err := gormtypes.RelayOutbox(ctx, tx, 1, func(ctx context.Context, events []*gormtypes.OutboxEvent) error {
+			return nil
+		})
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), 1, claimed)
+		published = []*gormtypes.OutboxEvent{}
+		relayed, err := gormtypes.RelayOutbox(ctx, postgresDb, 10, publish)
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), 1, relayed)
+		require.Equal(s.T(), "example.postgres.Invoice", published[0].MessageName)
+		return fmt.Errorf("rolled back")
+	})
+	require.Error(s.T(), err)
+	relayed, err = gormtypes.RelayOutbox(ctx, postgresDb, 10, publish)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 1, relayed)
+	// the running relay reports failures and retries them until the context is done
+	_, err = (&InvoiceProtos{{Number: "O-3"}}).Upsert(tenant, postgresDb)
+	require.NoError(s.T(), err)
+	relayCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	attempts := 0
+	reported := []error{}
+	err = gormtypes.RunOutboxRelay(relayCtx, postgresDb, 10, time.Millisecond, func(ctx context.Context, events []*gormtypes.OutboxEvent) error {
+		if attempts++; attempts <= 2 {
+			return fmt.Errorf("broker unavailable")
+		}
+		cancel()
+		return nil
+	}, func(err error) {
+		reported = append(reported, err)
+	})
+	require.ErrorIs(s.T(), err, context.Canceled)
+	require.Equal(s.T(), 3, attempts)
+	require.Len(s.T(), reported, 2)
+}
+
+// TestUpsertBatches tests that upserts with more models than fit in a statement's bind parameters, or than the model's
+// upsert_batch_size, are split into batches created in one transaction
+func (s *PostgresPluginSuite) TestUpsertBatches() {
+	ctx := context.Background()
+	size, err := UpsertBatchSize[*UserGormModel](postgresDb)
+	require.NoError(s.T(), err)
+	users := UserProtos{}
+	for i := 0; i <= size; i++ {
+		users = append(users, getPostgresUser(s.T()))
+	}
+	_, err = users.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	ids := lo.Map(users, func(user *User, _ int) string { return *user.Id })
+	fetchedUsers, err := GetByIds[*UserGormModel](ctx, postgresDb, ids, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), fetchedUsers, size+1)
+	_, _, err = DeleteUserGormModels(ctx, postgresDb, ids)
+	require.NoError(s.T(), err)
+	// companies are upserted two at a time
+	size, err = UpsertBatchSize[*CompanyGormModel](postgresDb)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), 2, size)
+	companies := getPostgresCompanys(s.T(), 5)
+	models, err := Upsert[*Company, *CompanyGormModel](ctx, postgresDb, companies)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), models, 5)
+	ids = lo.Map(companies, func(company *Company, _ int) string { return *company.Id })
+	fetchedCompanies, err := GetByIds[*CompanyGormModel](ctx, postgresDb, ids, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), fetchedCompanies, 5)
+	// the second batch upserts the same company twice, which fails, so the first batch is rolled back
+	companies = getPostgresCompanys(s.T(), 3)
+	companies = append(companies, companies[2])
+	_, err = Upsert[*Company, *CompanyGormModel](ctx, postgresDb, companies)
+	require.Error(s.T(), err)
+	fetchedCompanies, err = GetByIds[*CompanyGormModel](ctx, postgresDb, []string{*companies[0].Id, *companies[1].Id}, nil)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), fetchedCompanies)
+}
+
+// TestUpsertConflicts tests that upserts conflict on the conflict_columns, leave immutable fields as they are, and leave
+// existing rows as they are with upsert_do_nothing
+func (s *PostgresPluginSuite) TestUpsertConflicts() {
+	ctx := context.Background()
+	level := &StockLevel{Warehouse: "north", Sku: gofakeit.UUID(), Quantity: 10, FirstCountedBy: "alice"}
+	levels := StockLevelProtos{level}
+	_, err := levels.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	// another level of the same warehouse and sku updates the quantity of the existing row, but not who first counted it
+	levels = StockLevelProtos{{Warehouse: "north", Sku: level.Sku, Quantity: 7, FirstCountedBy: "bob"}}
+	_, err = levels.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	var fetchedLevels []*StockLevelGormModel
+	require.NoError(s.T(), postgresDb.Where("sku = ?", level.Sku).Find(&fetchedLevels).Error)
+	require.Len(s.T(), fetchedLevels, 1)
+	require.Equal(s.T(), *level.Id, *fetchedLevels[0].Id)
+	require.Equal(s.T(), int64(7), fetchedLevels[0].Quantity)
+	require.Equal(s.T(), "alice", fetchedLevels[0].FirstCountedBy)
+	// the generic upsert conflicts on the same columns
+	_, err = Upsert[*StockLevel, *StockLevelGormModel](ctx, postgresDb, []*StockLevel{{Warehouse: "north", Sku: level.Sku, Quantity: 3, FirstCountedBy: "carol"}})
+	require.NoError(s.T(), err)
+	fetchedLevels = nil
+	require.NoError(s.T(), postgresDb.Where("sku = ?", level.Sku).Find(&fetchedLevels).Error)
+	require.Len(s.T(), fetchedLevels, 1)
+	require.Equal(s.T(), int64(3), fetchedLevels[0].Quantity)
+	require.Equal(s.T(), "alice", fetchedLevels[0].FirstCountedBy)
+	// the same sku in another warehouse is another row
+	levels = StockLevelProtos{{Warehouse: "south", Sku: level.Sku, Quantity: 1}}
+	_, err = levels.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	fetchedLevels = nil
+	require.NoError(s.T(), postgresDb.Where("sku = ?", level.Sku).Find(&fetchedLevels).Error)
+	require.Len(s.T(), fetchedLevels, 2)
+	// reservations of an order that is already reserved are left as they are
+	reservation := &StockReservation{OrderNumber: gofakeit.UUID(), Sku: level.Sku, Quantity: 2}
+	reservations := StockReservationProtos{reservation}
+	_, err = reservations.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	reservations = StockReservationProtos{{OrderNumber: reservation.OrderNumber, Sku: level.Sku, Quantity: 5}}
+	_, err = reservations.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	_, err = Upsert[*StockReservation, *StockReservationGormModel](ctx, postgresDb, []*StockReservation{{OrderNumber: reservation.OrderNumber, Sku: level.Sku, Quantity: 6}})
+	require.NoError(s.T(), err)
+	var fetchedReservations []*StockReservationGormModel
+	require.NoError(s.T(), postgresDb.Where("order_number = ?", reservation.OrderNumber).Find(&fetchedReservations).Error)
+	require.Len(s.T(), fetchedReservations, 1)
+	require.Equal(s.T(), *reservation.Id, *fetchedReservations[0].Id)
+	require.Equal(s.T(), int64(2), fetchedReservations[0].Quantity)
+}
+
+// TestUpsertReturning tests that upserts update the models and protos with the rows as stored
+func (s *PostgresPluginSuite) TestUpsertReturning() {
+	ctx := context.Background()
+	level := &StockLevel{Warehouse: "north", Sku: gofakeit.UUID(), Quantity: 10, FirstCountedBy: "alice"}
+	levels := StockLevelProtos{level}
+	models, err := levels.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	require.NotEmpty(s.T(), level.CreatedAt)
+	require.NotEmpty(s.T(), level.UpdatedAt)
+	require.Equal(s.T(), *models[0].Id, *level.Id)
+	// upserting the level again returns the existing row's id, created at and immutable fields
+	update := &StockLevel{Warehouse: "north", Sku: level.Sku, Quantity: 7, FirstCountedBy: "bob", CreatedAt: "2020-01-01T00:00:00Z"}
+	levels = StockLevelProtos{update}
+	models, err = levels.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), *level.Id, *update.Id)
+	require.Equal(s.T(), *level.Id, *models[0].Id)
+	require.Equal(s.T(), level.CreatedAt, update.CreatedAt)
+	require.Equal(s.T(), int64(7), update.Quantity)
+	require.Equal(s.T(), "alice", update.FirstCountedBy)
+	// the generic upsert too
+	update = &StockLevel{Warehouse: "north", Sku: level.Sku, Quantity: 3, FirstCountedBy: "carol"}
+	genericModels, err := Upsert[*StockLevel, *StockLevelGormModel](ctx, postgresDb, []*StockLevel{update})
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), *level.Id, *update.Id)
+	require.Equal(s.T(), *level.Id, *genericModels[0].Id)
+	require.Equal(s.T(), "alice", update.FirstCountedBy)
+	// reservations that are left as they are return the existing row
+	reservation := &StockReservation{OrderNumber: gofakeit.UUID(), Sku: level.Sku, Quantity: 2}
+	_, err = Upsert[*StockReservation, *StockReservationGormModel](ctx, postgresDb, []*StockReservation{reservation})
+	require.NoError(s.T(), err)
+	again := &StockReservation{OrderNumber: reservation.OrderNumber, Sku: level.Sku, Quantity: 5}
+	reservations := StockReservationProtos{again}
+	_, err = reservations.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), *reservation.Id, *again.Id)
+	require.Equal(s.T(), int64(2), again.Quantity)
+	// in a batch with new reservations, each gets its own row
+	again = &StockReservation{OrderNumber: reservation.OrderNumber, Sku: level.Sku, Quantity: 5}
+	created := &StockReservation{OrderNumber: gofakeit.UUID(), Sku: level.Sku, Quantity: 3}
+	reservations = StockReservationProtos{again, created}
+	_, err = reservations.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), *reservation.Id, *again.Id)
+	require.Equal(s.T(), int64(2), again.Quantity)
+	require.NotEqual(s.T(), *reservation.Id, *created.Id)
+	require.Equal(s.T(), int64(3), created.Quantity)
+	fetched := StockReservationProtos{}
+	require.NoError(s.T(), fetched.GetByIds(ctx, postgresDb, []string{*created.Id}))
+	require.Len(s.T(), fetched, 1)
+	require.Equal(s.T(), created.OrderNumber, fetched[0].OrderNumber)
+}
+
+// TestUpsertConflictHistory tests that upserts updating the rows the models conflict with record the updates in history
+// and the outbox against the ids of those rows, and that the conflict columns of messages with a tenant column are
+// unique per tenant
+func (s *PostgresPluginSuite) TestUpsertConflictHistory() {
+	tenantA := gormtypes.WithTenant(context.Background(), uuid.New().String())
+	tenantB := gormtypes.WithTenant(context.Background(), uuid.New().String())
+	sku := gofakeit.UUID()
+	counts := StockCountProtos{{Warehouse: "north", Sku: sku, Quantity: 10}}
+	_, err := counts.Upsert(tenantA, postgresDb)
+	require.NoError(s.T(), err)
+	id := *counts[0].Id
+	// recounts with new ids update the existing row
+	recount := &StockCount{Id: lo.ToPtr(uuid.New().String()), Warehouse: "north", Sku: sku, Quantity: 7}
+	counts = StockCountProtos{recount}
+	_, err = counts.Upsert(tenantA, postgresDb)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), id, *recount.Id)
+	_, err = Upsert[*StockCount, *StockCountGormModel](tenantA, postgresDb, []*StockCount{{Warehouse: "north", Sku: sku, Quantity: 3}})
+	require.NoError(s.T(), err)
+	// assert
+	entries, err := StockCountHistory(tenantA, postgresDb, id)
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), []string{gormtypes.HistoryCreate, gormtypes.HistoryUpdate, gormtypes.HistoryUpdate},
+		lo.Map(entries, func(entry *StockCountHistoryEntry, _ int) string { return entry.Operation }))
+	require.Equal(s.T(), []int64{10, 7, 3}, lo.Map(entries, func(entry *StockCountHistoryEntry, _ int) int64 { return entry.After.Quantity }))
+	var events []*gormtypes.OutboxEvent
+	require.NoError(s.T(), postgresDb.Where("record_id = ?", id).Order("id").Find(&events).Error)
+	require.Equal(s.T(), []string{gormtypes.OutboxCreate, gormtypes.OutboxUpdate, gormtypes.OutboxUpdate},
+		lo.Map(events, func(event *gormtypes.OutboxEvent, _ int) string { return event.Operation }))
+	// another tenant counts the same warehouse and sku in a row of its own
+	other := StockCountProtos{{Warehouse: "north", Sku: sku, Quantity: 1}}
+	_, err = other.Upsert(tenantB, postgresDb)
+	require.NoError(s.T(), err)
+	require.NotEqual(s.T(), id, *other[0].Id)
+	var fetched []*StockCountGormModel
+	require.NoError(s.T(), postgresDb.Where("sku = ?", sku).Find(&fetched).Error)
+	require.Len(s.T(), fetched, 2)
+}
+
+// TestTransactionCallbacks tests that the callbacks of the generic upsert and delete run in their transaction, which is
+// rolled back when a callback returns an error
+func (s *PostgresPluginSuite) TestTransactionCallbacks() {
+	ctx := context.Background()
+	company := getPostgresCompany(s.T())
+	var called []*CompanyGormModel
+	_, err := Upsert[*Company, *CompanyGormModel](ctx, postgresDb, []*Company{company}, func(tx *gorm.DB, models []*CompanyGormModel) error {
+		called = models
+		// the upserted row is visible within the transaction
+		fetched, err := GetByIds[*CompanyGormModel](ctx, tx, []string{*models[0].Id}, nil)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), fetched, 1)
+		return nil
+	})
+	require.NoError(s.T(), err)
+	require.Len(s.T(), called, 1)
+	require.Equal(s.T(), *company.Id, *called[0].Id)
+	// an error of a callback rolls back the upsert
+	failed := errors.New("callback failed")
+	rolledBack := getPostgresCompany(s.T())
+	_, err = Upsert[*Company, *CompanyGormModel](ctx, postgresDb, []*Company{rolledBack}, func(tx *gorm.DB, models []*CompanyGormModel) error {
+		return failed
+	})
+	require.ErrorIs(s.T(), err, failed)
+	fetched, err := GetByIds[*CompanyGormModel](ctx, postgresDb, []string{*rolledBack.Id}, nil)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), fetched)
+	// and the delete
+	_, err = Delete[*CompanyGormModel](ctx, postgresDb, []string{*company.Id}, func(tx *gorm.DB, models []*CompanyGormModel) error {
+		return failed
+	})
+	require.ErrorIs(s.T(), err, failed)
+	fetched, err = GetByIds[*CompanyGormModel](ctx, postgresDb, []string{*company.Id}, nil)
+	require.NoError(s.T(), err)
+	require.Len(s.T(), fetched, 1)
+	_, err = Delete[*CompanyGormModel](ctx, postgresDb, []string{*company.Id}, func(tx *gorm.DB, models []*CompanyGormModel) error {
+		return nil
+	})
+	require.NoError(s.T(), err)
+	fetched, err = GetByIds[*CompanyGormModel](ctx, postgresDb, []string{*company.Id}, nil)
+	require.NoError(s.T(), err)
+	require.Empty(s.T(), fetched)
+}
+
+func (s *PostgresPluginSuite) TestTenantRowLevelSecurity() {
+	for _, statement := range InvoiceRowLevelSecurityDDL {
+		require.NoError(s.T(), postgresDb.Exec(statement).Error)
+	}
+	// the test user is a superuser, which bypasses row level security, so the policy is checked with a role of its own
+	require.NoError(s.T(), postgresDb.Exec("DO $$ BEGIN CREATE ROLE invoice_reader; EXCEPTION WHEN duplicate_object THEN null; END $$").Error)
+	require.NoError(s.T(), postgresDb.Exec("GRANT SELECT ON invoices TO invoice_reader").Error)
+	ctx := gormtypes.WithTenant(context.Background(), uuid.New().String())
+	invoices := InvoiceProtos{{Number: "RLS-1"}}
+	_, err := invoices.Upsert(ctx, postgresDb)
+	require.NoError(s.T(), err)
+	_, err = (&InvoiceProtos{{Number: "RLS-2"}}).Upsert(gormtypes.WithTenant(context.Background(), uuid.New().String()), postgresDb)
+	require.NoError(s.T(), err)
+	err = postgresDb.Transaction(func(tx *gorm.DB) error {
+		require.NoError(s.T(), tx.Exec("SET LOCAL ROLE invoice_reader").Error)
+		require.NoError(s.T(), gormtypes.SetTenantSetting(tx, invoices[0].TenantId))
+		// no tenant scope, the policy limits the rows
+		var models []*InvoiceGormModel
+		require.NoError(s.T(), tx.Find(&models).Error)
+		require.Len(s.T(), models, 1)
+		require.Equal(s.T(), *invoices[0].Id, *models[0].Id)
+		return nil
+	})
+	require.NoError(s.T(), err)
+	// the generated functions set the tenant themselves, without it the policy allows no rows
+	err = postgresDb.Transaction(func(tx *gorm.DB) error {
+		require.NoError(s.T(), tx.Exec("SET LOCAL ROLE invoice_reader").Error)
+		listed := InvoiceProtos{}
+		require.NoError(s.T(), listed.List(ctx, tx, -1, 0, nil))
+		require.Len(s.T(), listed, 1)
+		fetched := InvoiceProtos{}
+		require.NoError(s.T(), fetched.GetByIds(ctx, tx, []string{*invoices[0].Id}))
+		require.Len(s.T(), fetched, 1)
+		models, err := List[*InvoiceGormModel](ctx, tx, -1, 0, "", nil)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), models, 1)
+		return nil
+	})
+	require.NoError(s.T(), err)
+	// the service's create rpc sets the tenant as well, so its insert passes the policy's check
+	require.NoError(s.T(), postgresDb.Exec("GRANT INSERT, UPDATE ON invoices, invoices_history, outbox_events TO invoice_reader").Error)
+	err = postgresDb.Transaction(func(tx *gorm.DB) error {
+		require.NoError(s.T(), tx.Exec("SET LOCAL ROLE invoice_reader").Error)
+		created, err := NewInvoiceServiceGormServer(tx).CreateInvoice(ctx, &CreateInvoiceRequest{Invoice: &Invoice{Number: "RLS-3"}})
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), invoices[0].TenantId, created.TenantId)
+		require.Equal(s.T(), "RLS-3", created.Number)
+		return nil
+	})
+	require.NoError(s.T(), err)
+}
@@ -0,0 +1,66 @@
+package test
+
+import (
+	"testing"
+
+	. "github.com/catalystcommunity/protoc-gen-go-gorm/example/postgres"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// TestListPage tests that page sizes are defaulted and capped, and that page tokens round trip
+func TestListPage(t *testing.T) {
+	limit, offset, err := ListPage(0, "", 20, 100)
+	require.NoError(t, err)
+	require.Equal(t, 20, limit)
+	require.Equal(t, 0, offset)
+	limit, offset, err = ListPage(1000, NextPageToken(40), 20, 100)
+	require.NoError(t, err)
+	require.Equal(t, 100, limit)
+	require.Equal(t, 40, offset)
+	_, _, err = ListPage(-1, "", 20, 100)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, _, err = ListPage(10, "not a token", 20, 100)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// TestListOrder tests that only the resource's columns are accepted, as the order is used in the query
+func TestListOrder(t *testing.T) {
+	order, err := ListOrder("", UserServiceOrderColumns)
+	require.NoError(t, err)
+	require.Equal(t, "id", order)
+	order, err = ListOrder("created_at desc, a_string", UserServiceOrderColumns)
+	require.NoError(t, err)
+	require.Equal(t, "created_at desc, a_string, id", order)
+	for _, invalid := range []string{"unknown", "a_string sideways", "a_string desc; drop table users", "a_string,"} {
+		_, err = ListOrder(invalid, UserServiceOrderColumns)
+		require.Equal(t, codes.InvalidArgument, status.Code(err), invalid)
+	}
+}
+
+// TestApplyFieldMask tests that only the fields in the mask's paths are set, and that unset fields are cleared
+func TestApplyFieldMask(t *testing.T) {
+	destination := &User{AString: "kept", AnInt32: 1, OptionalScalarField: lo.ToPtr("cleared"), Shipping: &PostalAddress{City: "kept"}}
+	source := &User{AString: "ignored", AnInt32: 2, Shipping: &PostalAddress{City: "ignored", PostalCode: "12345"}}
+	mask := &fieldmaskpb.FieldMask{Paths: []string{"an_int32", "optional_scalar_field", "shipping.postal_code"}}
+	require.NoError(t, ApplyFieldMask(destination, source, mask))
+	require.Equal(t, "kept", destination.AString)
+	require.Equal(t, int32(2), destination.AnInt32)
+	require.Nil(t, destination.OptionalScalarField)
+	require.Equal(t, "kept", destination.Shipping.City)
+	require.Equal(t, "12345", destination.Shipping.PostalCode)
+	// a path into a message source doesn't have only clears the path's field of destination's message
+	source = &User{}
+	require.NoError(t, ApplyFieldMask(destination, source, &fieldmaskpb.FieldMask{Paths: []string{"shipping.postal_code"}}))
+	require.Equal(t, "kept", destination.Shipping.City)
+	require.Empty(t, destination.Shipping.PostalCode)
+	// and leaves a message destination doesn't have unset
+	destination.Shipping = nil
+	require.NoError(t, ApplyFieldMask(destination, source, &fieldmaskpb.FieldMask{Paths: []string{"shipping.postal_code"}}))
+	require.Nil(t, destination.Shipping)
+	err := ApplyFieldMask(destination, source, &fieldmaskpb.FieldMask{Paths: []string{"unknown"}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}